@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"runtime/debug"
@@ -16,12 +17,15 @@ var (
 	version = "unknown"
 	commit  = "?"
 	date    = ""
+
+	// Populated by `golangci-lint custom` during build (JSON).
+	plugins = ""
 )
 
 func main() {
 	info := createBuildInfo()
 
-	if err := commands.Execute(info); err != nil {
+	if err := commands.Execute(&info); err != nil {
 		_, _ = fmt.Fprintf(os.Stderr, "Failed executing command with error: %v\n", err)
 		os.Exit(exitcodes.Failure)
 	}
@@ -35,6 +39,11 @@ func createBuildInfo() commands.BuildInfo {
 		Date:      date,
 	}
 
+	if plugins != "" {
+		// The value is generated by `golangci-lint custom`: an invalid value is ignored.
+		_ = json.Unmarshal([]byte(plugins), &info.Plugins)
+	}
+
 	buildInfo, available := debug.ReadBuildInfo()
 	if !available {
 		return info
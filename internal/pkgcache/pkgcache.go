@@ -30,11 +30,18 @@ const (
 type Cache struct {
 	lowLevelCache *cache.Cache
 	pkgHashes     sync.Map
+	memory        *sync.Map // the last data of each package and key (optional)
 	sw            *timeutils.Stopwatch
 	log           logutils.Log  // not used now, but may be needed for future debugging purposes
 	ioSem         chan struct{} // semaphore limiting parallel IO
 }
 
+// memoryEntry is the last data stored for a package and a key.
+type memoryEntry struct {
+	id   cache.ActionID
+	data []byte
+}
+
 func NewCache(sw *timeutils.Stopwatch, log logutils.Log) (*Cache, error) {
 	c, err := cache.Default()
 	if err != nil {
@@ -48,6 +55,20 @@ func NewCache(sw *timeutils.Stopwatch, log logutils.Log) (*Cache, error) {
 	}, nil
 }
 
+// KeepInMemory keeps the last data of each package and key in memory, in addition to the low-level cache.
+// It's useful for long-running processes (e.g. the daemon) analyzing the same packages several times.
+func (c *Cache) KeepInMemory() {
+	c.memory = &sync.Map{}
+}
+
+// Invalidate removes the hashes of the packages: they will be computed again from the content of their files.
+// The packages must be invalidated when their files, or the files of their dependencies, change.
+func (c *Cache) Invalidate(pkgs ...*packages.Package) {
+	for _, pkg := range pkgs {
+		c.pkgHashes.Delete(pkg)
+	}
+}
+
 func (c *Cache) Trim() {
 	c.sw.TrackStage("trim", func() {
 		c.lowLevelCache.Trim()
@@ -79,6 +100,10 @@ func (c *Cache) Put(pkg *packages.Package, mode HashMode, key string, data any)
 	if err != nil {
 		return fmt.Errorf("failed to calculate package %s action id: %w", pkg.Name, err)
 	}
+	if c.memory != nil {
+		c.memory.Store(memoryKey(pkg, key), memoryEntry{id: aID, data: buf.Bytes()})
+	}
+
 	c.ioSem <- struct{}{}
 	c.sw.TrackStage("cache io", func() {
 		err = c.lowLevelCache.PutBytes(aID, buf.Bytes())
@@ -110,12 +135,7 @@ func (c *Cache) Get(pkg *packages.Package, mode HashMode, key string, data any)
 		return fmt.Errorf("failed to calculate package %s action id: %w", pkg.Name, err)
 	}
 
-	var b []byte
-	c.ioSem <- struct{}{}
-	c.sw.TrackStage("cache io", func() {
-		b, _, err = c.lowLevelCache.GetBytes(aID)
-	})
-	<-c.ioSem
+	b, err := c.getBytes(pkg, key, aID)
 	if err != nil {
 		if cache.IsErrMissing(err) {
 			return ErrMissing
@@ -149,6 +169,33 @@ func (c *Cache) pkgActionID(pkg *packages.Package, mode HashMode) (cache.ActionI
 	return key.Sum(), nil
 }
 
+func (c *Cache) getBytes(pkg *packages.Package, key string, aID cache.ActionID) ([]byte, error) {
+	if c.memory != nil {
+		if entry, ok := c.memory.Load(memoryKey(pkg, key)); ok && entry.(memoryEntry).id == aID {
+			return entry.(memoryEntry).data, nil
+		}
+	}
+
+	var b []byte
+	var err error
+
+	c.ioSem <- struct{}{}
+	c.sw.TrackStage("cache io", func() {
+		b, _, err = c.lowLevelCache.GetBytes(aID)
+	})
+	<-c.ioSem
+
+	if err == nil && c.memory != nil {
+		c.memory.Store(memoryKey(pkg, key), memoryEntry{id: aID, data: b})
+	}
+
+	return b, err
+}
+
+func memoryKey(pkg *packages.Package, key string) string {
+	return pkg.ID + "\x00" + key
+}
+
 // packageHash computes a package's hash. The hash is based on all Go
 // files that make up the package, as well as the hashes of imported
 // packages.
@@ -3,6 +3,7 @@ package commands
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
@@ -20,17 +21,23 @@ type configCommand struct {
 
 	opts       config.LoaderOptions
 	verifyOpts verifyOptions
+	printOpts  printOptions
 
 	buildInfo BuildInfo
 
 	log logutils.Log
+
+	cfg *config.Config
+
+	// sources contains the sources setting each value.
+	sources map[string][]config.Source
 }
 
-func newConfigCommand(log logutils.Log, info BuildInfo) *configCommand {
+func newConfigCommand(log logutils.Log, info *BuildInfo) *configCommand {
 	c := &configCommand{
 		viper:     viper.New(),
 		log:       log,
-		buildInfo: info,
+		buildInfo: *info,
 	}
 
 	configCmd := &cobra.Command{
@@ -53,6 +60,15 @@ func newConfigCommand(log logutils.Log, info BuildInfo) *configCommand {
 		SilenceErrors:     true,
 	}
 
+	printCommand := &cobra.Command{
+		Use:               "print",
+		Short:             "Print the effective configuration, and the source (default, file, flag, env) of each value",
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE:              c.executePrint,
+		SilenceUsage:      true,
+	}
+
 	configCmd.AddCommand(
 		&cobra.Command{
 			Use:               "path",
@@ -62,6 +78,7 @@ func newConfigCommand(log logutils.Log, info BuildInfo) *configCommand {
 			Run:               c.executePath,
 		},
 		verifyCommand,
+		printCommand,
 	)
 
 	flagSet := configCmd.PersistentFlags()
@@ -74,23 +91,36 @@ func newConfigCommand(log logutils.Log, info BuildInfo) *configCommand {
 	verifyFlagSet.StringVar(&c.verifyOpts.schemaURL, "schema", "", color.GreenString("JSON schema URL"))
 	_ = verifyFlagSet.MarkHidden("schema")
 
+	// The flags of the run command change the effective configuration.
+	printFlagSet := printCommand.Flags()
+	printFlagSet.SortFlags = false // sort them as they are defined here
+
+	printFlagSet.StringVar(&c.printOpts.format, "format", printFormatYAML,
+		color.GreenString(fmt.Sprintf("Output format: %s", strings.Join(printFormats, ", "))))
+
+	setupLintersFlagSet(c.viper, printFlagSet)
+	setupRunFlagSet(c.viper, printFlagSet)
+	setupOutputFlagSet(c.viper, printFlagSet)
+	setupIssuesFlagSet(c.viper, printFlagSet)
+
 	c.cmd = configCmd
 
 	return c
 }
 
 func (c *configCommand) preRunE(cmd *cobra.Command, args []string) error {
-	// The command doesn't depend on the real configuration.
-	// It only needs to know the path of the configuration file.
-	cfg := config.NewDefault()
+	// Only the print command has the flags of the run command.
+	c.cfg = config.NewDefault()
 
-	loader := config.NewLoader(c.log.Child(logutils.DebugKeyConfigReader), c.viper, cmd.Flags(), c.opts, cfg, args)
+	loader := config.NewLoader(c.log.Child(logutils.DebugKeyConfigReader), c.viper, cmd.Flags(), c.opts, c.cfg, args)
 
 	err := loader.Load(config.LoadOptions{})
 	if err != nil {
 		return fmt.Errorf("can't load config: %w", err)
 	}
 
+	c.sources = loader.Sources()
+
 	return nil
 }
 
@@ -0,0 +1,352 @@
+package commands
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/maps"
+	"gopkg.in/yaml.v3"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/lint/lintersdb"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+const (
+	printFormatYAML = "yaml"
+	printFormatJSON = "json"
+	printFormatTOML = "toml"
+)
+
+var printFormats = []string{printFormatYAML, printFormatJSON, printFormatTOML}
+
+type printOptions struct {
+	format string // Flag only.
+}
+
+// printedConfig is the document printed in JSON and TOML:
+// the YAML document is the configuration, annotated with comments.
+type printedConfig struct {
+	Config         map[string]any             `json:"config"          toml:"config"`
+	EnabledLinters []string                   `json:"enabled-linters" toml:"enabled-linters"`
+	Sources        map[string][]config.Source `json:"sources"         toml:"sources"`
+}
+
+func (c *configCommand) executePrint(cmd *cobra.Command, _ []string) error {
+	if !slices.Contains(printFormats, c.printOpts.format) {
+		return fmt.Errorf("unsupported format %q: must be one of %s", c.printOpts.format, strings.Join(printFormats, ", "))
+	}
+
+	err := c.cfg.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+
+	enabledLinters, err := c.getEnabledLinters()
+	if err != nil {
+		return err
+	}
+
+	printer := newConfigPrinter(c.sources)
+
+	node := printer.node("", reflect.ValueOf(c.cfg).Elem())
+	if node == nil {
+		node = &yaml.Node{Kind: yaml.MappingNode}
+	}
+
+	if c.printOpts.format == printFormatYAML {
+		setEnabledLintersComment(node, enabledLinters)
+
+		return printYAML(cmd.OutOrStdout(), node)
+	}
+
+	doc := printedConfig{
+		Config:         map[string]any{},
+		EnabledLinters: enabledLinters,
+		Sources:        printer.annotations,
+	}
+
+	err = node.Decode(&doc.Config)
+	if err != nil {
+		return fmt.Errorf("can't convert the configuration: %w", err)
+	}
+
+	if c.printOpts.format == printFormatTOML {
+		return toml.NewEncoder(cmd.OutOrStdout()).Encode(doc)
+	}
+
+	encoder := json.NewEncoder(cmd.OutOrStdout())
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(doc)
+}
+
+// getEnabledLinters returns the names of the linters enabled by the configuration.
+func (c *configCommand) getEnabledLinters() ([]string, error) {
+	dbManager, err := lintersdb.NewManager(c.log.Child(logutils.DebugKeyLintersDB), c.cfg,
+		lintersdb.NewLinterBuilder(), lintersdb.NewPluginModuleBuilder(c.log), lintersdb.NewPluginGoBuilder(c.log),
+		lintersdb.NewPluginCommandBuilder(c.log), lintersdb.NewPluginVetToolBuilder(c.log))
+	if err != nil {
+		return nil, err
+	}
+
+	enabledLintersMap, err := dbManager.GetEnabledLintersMap()
+	if err != nil {
+		return nil, fmt.Errorf("can't get enabled linters: %w", err)
+	}
+
+	names := maps.Keys(enabledLintersMap)
+	slices.Sort(names)
+
+	return names, nil
+}
+
+// setEnabledLintersComment adds the enabled linters to the comment of the linters section.
+func setEnabledLintersComment(node *yaml.Node, enabledLinters []string) {
+	comment := "Enabled linters: " + strings.Join(enabledLinters, ", ")
+
+	for i := 0; i < len(node.Content); i += 2 {
+		if node.Content[i].Value == "linters" {
+			node.Content[i].HeadComment = comment
+			return
+		}
+	}
+
+	node.FootComment = comment
+}
+
+func printYAML(w io.Writer, node *yaml.Node) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+
+	err := encoder.Encode(node)
+	if err != nil {
+		return fmt.Errorf("can't print the configuration: %w", err)
+	}
+
+	return encoder.Close()
+}
+
+// configPrinter converts a configuration to a YAML document.
+// The values are annotated with their sources: the values without sources are the default values.
+// The zero values are omitted.
+type configPrinter struct {
+	// sources contains the sources setting each value (key -> sources).
+	sources map[string][]config.Source
+
+	// annotations contains the sources of the printed values (key -> sources).
+	annotations map[string][]config.Source
+}
+
+func newConfigPrinter(sources map[string][]config.Source) *configPrinter {
+	return &configPrinter{
+		sources:     sources,
+		annotations: map[string][]config.Source{},
+	}
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// node returns the YAML node of a value, or nil if the value is a zero value.
+func (p *configPrinter) node(key string, value reflect.Value) *yaml.Node {
+	if !value.IsValid() || value.IsZero() {
+		return nil
+	}
+
+	if value.Type() == durationType {
+		return scalarNode(value.Interface().(time.Duration).String())
+	}
+
+	if marshaler, ok := value.Interface().(encoding.TextMarshaler); ok {
+		text, err := marshaler.MarshalText()
+		if err == nil {
+			return scalarNode(string(text))
+		}
+	}
+
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		return p.node(key, value.Elem())
+
+	case reflect.Struct:
+		node := &yaml.Node{Kind: yaml.MappingNode}
+		p.addFields(node, key, value)
+
+		return emptyToNil(node)
+
+	case reflect.Map:
+		node := &yaml.Node{Kind: yaml.MappingNode}
+
+		keys := value.MapKeys()
+		slices.SortFunc(keys, func(a, b reflect.Value) int {
+			return strings.Compare(fmt.Sprint(a.Interface()), fmt.Sprint(b.Interface()))
+		})
+
+		for _, k := range keys {
+			p.addEntry(node, key, fmt.Sprint(k.Interface()), value.MapIndex(k))
+		}
+
+		return emptyToNil(node)
+
+	case reflect.Slice, reflect.Array:
+		if value.Len() == 0 {
+			return nil
+		}
+
+		node := &yaml.Node{Kind: yaml.SequenceNode}
+
+		for i := range value.Len() {
+			// The values inside the lists are not annotated.
+			item := p.node(listItemKey, value.Index(i))
+			if item == nil {
+				// The zero values are kept inside the lists.
+				item = &yaml.Node{}
+				_ = item.Encode(value.Index(i).Interface())
+			}
+
+			node.Content = append(node.Content, item)
+		}
+
+		return node
+
+	default:
+		node := &yaml.Node{}
+		if err := node.Encode(value.Interface()); err != nil {
+			return scalarNode(fmt.Sprint(value.Interface()))
+		}
+
+		return node
+	}
+}
+
+// listItemKey is the key of the values inside the lists.
+const listItemKey = "[]"
+
+// addFields adds the fields of a struct to a mapping node.
+// The names of the fields are the names used by the configuration files (mapstructure tags).
+func (p *configPrinter) addFields(node *yaml.Node, key string, value reflect.Value) {
+	for i := range value.NumField() {
+		field := value.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(field.Tag.Get("mapstructure"), ",")
+
+		switch {
+		case name == "-":
+			continue
+
+		case opts == "squash":
+			p.addFields(node, key, value.Field(i))
+			continue
+
+		case name == "":
+			name = strings.ToLower(field.Name)
+		}
+
+		p.addEntry(node, key, name, value.Field(i))
+	}
+}
+
+// addEntry adds a key and its value to a mapping node.
+func (p *configPrinter) addEntry(node *yaml.Node, prefix, name string, value reflect.Value) {
+	key := name
+	if prefix != "" {
+		key = prefix + "." + name
+	}
+
+	valueNode := p.node(key, value)
+	if valueNode == nil {
+		return
+	}
+
+	keyNode := scalarNode(name)
+	keyNode.LineComment = p.comment(key, valueNode.Kind != yaml.MappingNode)
+
+	node.Content = append(node.Content, keyNode, valueNode)
+}
+
+// comment returns the comment describing the sources of a value.
+// The values without sources are the default values, unless they are inside a value with sources.
+func (p *configPrinter) comment(key string, leaf bool) string {
+	if strings.Contains(key, listItemKey) {
+		return ""
+	}
+
+	key = strings.ToLower(key)
+
+	sources := p.sources[key]
+
+	if len(sources) == 0 {
+		if !leaf || p.hasParentSource(key) {
+			return ""
+		}
+
+		sources = []config.Source{{Kind: config.SourceDefault}}
+	}
+
+	var parts []string
+
+	for _, source := range sources {
+		source = prettySource(source)
+
+		p.annotations[key] = append(p.annotations[key], source)
+
+		parts = append(parts, source.String())
+	}
+
+	return strings.Join(parts, "; ")
+}
+
+func (p *configPrinter) hasParentSource(key string) bool {
+	for i := strings.LastIndex(key, "."); i > 0; i = strings.LastIndex(key, ".") {
+		key = key[:i]
+
+		if len(p.sources[key]) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// prettySource returns a source with the shortest relative paths of its files.
+func prettySource(source config.Source) config.Source {
+	if source.Kind != config.SourceFile {
+		return source
+	}
+
+	var names []string
+
+	for _, name := range source.Names {
+		if pretty, err := fsutils.ShortestRelPath(name, ""); err == nil {
+			name = pretty
+		}
+
+		names = append(names, name)
+	}
+
+	return config.Source{Kind: source.Kind, Names: names}
+}
+
+func scalarNode(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+}
+
+func emptyToNil(node *yaml.Node) *yaml.Node {
+	if len(node.Content) == 0 {
+		return nil
+	}
+
+	return node
+}
@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+)
+
+func Test_configPrinter(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{
+		Run: config.Run{
+			Timeout:   time.Minute,
+			BuildTags: []string{"a"},
+		},
+		Linters: config.Linters{
+			Enable: []string{"gosec", "errcheck"},
+		},
+		Issues: config.Issues{
+			ExcludeRules: []config.ExcludeRule{{
+				BaseRule: config.BaseRule{Linters: []string{"errcheck"}, Path: `_test\.go`},
+			}},
+		},
+	}
+
+	printer := newConfigPrinter(map[string][]config.Source{
+		"run.timeout": {{Kind: config.SourceFile, Names: []string{"/a.yml"}}},
+		"linters.enable": {
+			{Kind: config.SourceFlag, Names: []string{"--enable"}},
+			{Kind: config.SourceFile, Names: []string{"/b.yml", "/a.yml"}},
+		},
+	})
+
+	out := new(strings.Builder)
+
+	encoder := yaml.NewEncoder(out)
+	encoder.SetIndent(2)
+
+	require.NoError(t, encoder.Encode(printer.node("", reflect.ValueOf(cfg).Elem())))
+
+	expected := `run:
+  timeout: 1m0s # file: /a.yml
+  build-tags: # default
+    - a
+linters:
+  enable: # flag: --enable; file: /b.yml, /a.yml
+    - gosec
+    - errcheck
+issues:
+  exclude-rules: # default
+    - linters:
+        - errcheck
+      path: _test\.go
+`
+
+	assert.Equal(t, expected, out.String())
+
+	assert.Equal(t, []config.Source{{Kind: config.SourceDefault}}, printer.annotations["run.build-tags"])
+}
@@ -1,6 +1,8 @@
 package commands
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -18,8 +20,12 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"github.com/golangci/golangci-lint/pkg/exitcodes"
+	"github.com/golangci/golangci-lint/pkg/lint/lintersdb"
 )
 
+// pluginsSchemaURL is the URL of the merged JSON schemas of the module plugins, only used to identify the schema inside the errors.
+const pluginsSchemaURL = "plugin://golangci-lint/plugins.jsonschema.json"
+
 type verifyOptions struct {
 	schemaURL string // For debugging purpose only (Flag only).
 }
@@ -31,29 +37,44 @@ func (c *configCommand) executeVerify(cmd *cobra.Command, _ []string) error {
 		os.Exit(exitcodes.NoConfigFileDetected)
 	}
 
-	schemaURL, err := createSchemaURL(cmd.Flags(), c.buildInfo)
+	schemaURL, err := createSchemaURL(cmd.Flags(), &c.buildInfo)
 	if err != nil {
 		return fmt.Errorf("get JSON schema: %w", err)
 	}
 
-	err = validateConfiguration(schemaURL, usedConfigFile)
+	m, err := decodeConfigFile(usedConfigFile)
 	if err != nil {
-		var v *jsonschema.ValidationError
-		if !errors.As(err, &v) {
-			return fmt.Errorf("[%s] validate: %w", usedConfigFile, err)
-		}
+		return fmt.Errorf("[%s] validate: %w", usedConfigFile, err)
+	}
+
+	invalid, err := printValidationError(cmd, validateConfiguration(schemaURL, m))
+	if err != nil {
+		return fmt.Errorf("[%s] validate: %w", usedConfigFile, err)
+	}
 
-		detail := v.DetailedOutput()
+	// The settings of the module plugins are validated against the JSON schemas of the plugins.
+	pluginSchemas, err := lintersdb.NewPluginModuleBuilder(c.log).SettingsSchemas(c.cfg)
+	if err != nil {
+		return fmt.Errorf("get plugins JSON schemas: %w", err)
+	}
+
+	if len(pluginSchemas) > 0 {
+		pluginsInvalid, errP := printValidationError(cmd, validatePluginsSettings(pluginSchemas, m))
+		if errP != nil {
+			return fmt.Errorf("[%s] validate plugins settings: %w", usedConfigFile, errP)
+		}
 
-		printValidationDetail(cmd, &detail)
+		invalid = invalid || pluginsInvalid
+	}
 
+	if invalid {
 		return errors.New("the configuration contains invalid elements")
 	}
 
 	return nil
 }
 
-func createSchemaURL(flags *pflag.FlagSet, buildInfo BuildInfo) (string, error) {
+func createSchemaURL(flags *pflag.FlagSet, buildInfo *BuildInfo) (string, error) {
 	schemaURL, err := flags.GetString("schema")
 	if err != nil {
 		return "", fmt.Errorf("get schema flag: %w", err)
@@ -99,7 +120,7 @@ func createSchemaURL(flags *pflag.FlagSet, buildInfo BuildInfo) (string, error)
 	return schemaURL, nil
 }
 
-func validateConfiguration(schemaPath, targetFile string) error {
+func validateConfiguration(schemaPath string, m any) error {
 	httploader.Client = &http.Client{Timeout: 2 * time.Second}
 
 	compiler := jsonschema.NewCompiler()
@@ -110,27 +131,79 @@ func validateConfiguration(schemaPath, targetFile string) error {
 		return fmt.Errorf("compile schema: %w", err)
 	}
 
-	var m any
+	return schema.Validate(m)
+}
 
-	switch strings.ToLower(filepath.Ext(targetFile)) {
-	case ".yaml", ".yml", ".json":
-		m, err = decodeYamlFile(targetFile)
-		if err != nil {
-			return err
+// validatePluginsSettings validates the settings of the module plugins:
+// the JSON schemas of the plugins are merged into the schema of the section `linters-settings.custom`.
+func validatePluginsSettings(pluginSchemas map[string][]byte, m any) error {
+	custom := map[string]any{}
+	for name, pluginSchema := range pluginSchemas {
+		custom[name] = map[string]any{
+			"properties": map[string]any{"settings": json.RawMessage(pluginSchema)},
 		}
+	}
+
+	data, err := json.Marshal(map[string]any{
+		"properties": map[string]any{
+			"linters-settings": map[string]any{
+				"properties": map[string]any{
+					"custom": map[string]any{"properties": custom},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("merge plugins schemas: %w", err)
+	}
+
+	compiler := jsonschema.NewCompiler()
+	compiler.Draft = jsonschema.Draft7
+
+	err = compiler.AddResource(pluginsSchemaURL, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("add plugins schema: %w", err)
+	}
+
+	schema, err := compiler.Compile(pluginsSchemaURL)
+	if err != nil {
+		return fmt.Errorf("compile plugins schema: %w", err)
+	}
+
+	return schema.Validate(m)
+}
+
+// printValidationError prints the details of a validation error.
+// It returns true if the configuration is invalid, or an error if the validation has failed.
+func printValidationError(cmd *cobra.Command, err error) (bool, error) {
+	if err == nil {
+		return false, nil
+	}
+
+	var v *jsonschema.ValidationError
+	if !errors.As(err, &v) {
+		return false, err
+	}
+
+	detail := v.DetailedOutput()
+
+	printValidationDetail(cmd, &detail)
+
+	return true, nil
+}
+
+func decodeConfigFile(filename string) (any, error) {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".yaml", ".yml", ".json":
+		return decodeYamlFile(filename)
 
 	case ".toml":
-		m, err = decodeTomlFile(targetFile)
-		if err != nil {
-			return err
-		}
+		return decodeTomlFile(filename)
 
 	default:
 		// unsupported
-		return errors.New("unsupported configuration format")
+		return nil, errors.New("unsupported configuration format")
 	}
-
-	return schema.Validate(m)
 }
 
 func printValidationDetail(cmd *cobra.Command, detail *jsonschema.Detailed) {
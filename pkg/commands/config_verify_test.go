@@ -72,7 +72,7 @@ func Test_createSchemaURL(t *testing.T) {
 				_ = flags.Set("schema", test.flag)
 			}
 
-			schemaURL, err := createSchemaURL(flags, test.info)
+			schemaURL, err := createSchemaURL(flags, &test.info)
 			require.NoError(t, err)
 
 			assert.Equal(t, test.expected, schemaURL)
@@ -131,8 +131,70 @@ func Test_createSchemaURL_error(t *testing.T) {
 			flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
 			flags.String("schema", "", "")
 
-			_, err := createSchemaURL(flags, test.info)
+			_, err := createSchemaURL(flags, &test.info)
 			require.EqualError(t, err, test.expected)
 		})
 	}
 }
+
+func Test_validatePluginsSettings(t *testing.T) {
+	pluginSchemas := map[string][]byte{
+		"example": []byte(`{"type": "object", "additionalProperties": false, "properties": {"message": {"type": "string"}}}`),
+	}
+
+	testCases := []struct {
+		desc     string
+		settings any
+	}{
+		{
+			desc:     "valid settings",
+			settings: map[string]any{"message": "hello"},
+		},
+		{
+			desc: "no settings",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			example := map[string]any{"type": "module"}
+			if test.settings != nil {
+				example["settings"] = test.settings
+			}
+
+			m := map[string]any{
+				"linters-settings": map[string]any{
+					"custom": map[string]any{"example": example},
+				},
+			}
+
+			err := validatePluginsSettings(pluginSchemas, m)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_validatePluginsSettings_error(t *testing.T) {
+	pluginSchemas := map[string][]byte{
+		"example": []byte(`{"type": "object", "additionalProperties": false, "properties": {"message": {"type": "string"}}}`),
+	}
+
+	m := map[string]any{
+		"linters-settings": map[string]any{
+			"custom": map[string]any{
+				"example": map[string]any{
+					"type":     "module",
+					"settings": map[string]any{"message": 1},
+				},
+			},
+		},
+	}
+
+	err := validatePluginsSettings(pluginSchemas, m)
+
+	require.EqualError(t, err, "jsonschema: '/linters-settings/custom/example/settings/message' does not validate with "+
+		"plugin://golangci-lint/plugins.jsonschema.json#/properties/linters-settings/properties/custom/properties/example"+
+		"/properties/settings/properties/message/type: expected string, but got number")
+}
@@ -5,6 +5,7 @@ import (
 	"log"
 	"os"
 
+	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 
 	"github.com/golangci/golangci-lint/pkg/commands/internal"
@@ -13,8 +14,13 @@ import (
 
 const envKeepTempFiles = "CUSTOM_GCL_KEEP_TEMP_FILES"
 
+type customOptions struct {
+	Offline bool
+}
+
 type customCommand struct {
-	cmd *cobra.Command
+	cmd  *cobra.Command
+	opts customOptions
 
 	cfg *internal.Configuration
 
@@ -33,6 +39,12 @@ func newCustomCommand(logger logutils.Log) *customCommand {
 		SilenceUsage: true,
 	}
 
+	fs := customCmd.Flags()
+	fs.SortFlags = false // sort them as they are defined here
+
+	fs.BoolVar(&c.opts.Offline, "offline", false,
+		color.GreenString("Resolve golangci-lint and the plugins from the module cache only (GOPROXY=off, GOFLAGS=-mod=mod)"))
+
 	c.cmd = customCmd
 
 	return c
@@ -70,7 +82,7 @@ func (c *customCommand) runE(cmd *cobra.Command, _ []string) error {
 		_ = os.RemoveAll(tmp)
 	}()
 
-	err = internal.NewBuilder(c.log, c.cfg, tmp).Build(cmd.Context())
+	err = internal.NewBuilder(c.log, c.cfg, tmp, c.opts.Offline).Build(cmd.Context())
 	if err != nil {
 		return fmt.Errorf("build process: %w", err)
 	}
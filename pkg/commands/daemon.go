@@ -0,0 +1,274 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"slices"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"github.com/golangci/golangci-lint/internal/pkgcache"
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/daemon"
+	"github.com/golangci/golangci-lint/pkg/exitcodes"
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/goanalysis/load"
+	"github.com/golangci/golangci-lint/pkg/goutil"
+	"github.com/golangci/golangci-lint/pkg/lint"
+	"github.com/golangci/golangci-lint/pkg/lint/lintersdb"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/report"
+	"github.com/golangci/golangci-lint/pkg/result"
+	"github.com/golangci/golangci-lint/pkg/timeutils"
+)
+
+// daemonCommand analyzes the packages of the working directory for the `run --daemon` commands.
+// The loaded packages, the content of the files, and the cached issues and facts are kept in memory between the runs:
+// only the packages of the modified files, and their reverse dependencies, are analyzed again.
+//
+// The daemon doesn't lock the cache: the client holds the lock during the run.
+type daemonCommand struct {
+	cmd *cobra.Command
+
+	buildInfo BuildInfo
+
+	log logutils.Log
+
+	dir string
+
+	goenv *goutil.Env
+
+	fileCache *fsutils.FileCache
+	pkgCache  *pkgcache.Cache
+	pkgsCache *lint.PackagesCache
+}
+
+func newDaemonCommand(logger logutils.Log, info *BuildInfo) *daemonCommand {
+	c := &daemonCommand{
+		buildInfo: *info,
+		log:       logger,
+	}
+
+	c.cmd = &cobra.Command{
+		Use:               "daemon",
+		Short:             "Run a daemon analyzing the packages of the working directory for the `run --daemon` commands",
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE:              c.execute,
+		PreRunE:           c.preRunE,
+		SilenceUsage:      true,
+	}
+
+	return c
+}
+
+func (c *daemonCommand) preRunE(_ *cobra.Command, _ []string) error {
+	dir, err := fsutils.Getwd()
+	if err != nil {
+		return err
+	}
+
+	c.dir = dir
+
+	c.goenv = goutil.NewEnv(c.log.Child(logutils.DebugKeyGoEnv))
+
+	c.fileCache = fsutils.NewFileCache()
+
+	sw := timeutils.NewStopwatch("pkgcache", c.log.Child(logutils.DebugKeyStopwatch))
+
+	c.pkgCache, err = pkgcache.NewCache(sw, c.log.Child(logutils.DebugKeyPkgCache))
+	if err != nil {
+		return fmt.Errorf("failed to build packages cache: %w", err)
+	}
+
+	c.pkgCache.KeepInMemory()
+
+	c.pkgsCache = lint.NewPackagesCache()
+
+	return nil
+}
+
+func (c *daemonCommand) execute(_ *cobra.Command, _ []string) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := c.goenv.Discover(ctx); err != nil {
+		c.log.Warnf("Failed to discover go env: %s", err)
+	}
+
+	if !logutils.HaveDebugTag(logutils.DebugKeyLintersOutput) {
+		// Don't allow linters and loader to print anything
+		log.SetOutput(io.Discard)
+	}
+
+	socketPath, err := daemon.SocketPath(c.dir)
+	if err != nil {
+		return err
+	}
+
+	server := daemon.NewServer(c.log.Child(logutils.DebugKeyDaemon), c, c.dir)
+
+	return server.Run(ctx, socketPath)
+}
+
+// Lint implements [daemon.Linter].
+func (c *daemonCommand) Lint(ctx context.Context, req *daemon.Request, reportData *report.Data) ([]result.Issue, error) {
+	logger := report.NewLogWrapper(c.log, reportData)
+
+	cfg, err := c.loadConfig(req)
+	if err != nil {
+		return nil, fmt.Errorf("can't load config: %w", err)
+	}
+
+	// The fixes are applied by the client.
+	cfg.Issues.NeedFix = false
+	cfg.Issues.FixDiff = false
+
+	if err = initHashSalt(c.buildInfo.Version, cfg); err != nil {
+		return nil, fmt.Errorf("failed to init hash salt: %w", err)
+	}
+
+	setMemoryLimit(cfg)
+
+	if cfg.Run.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.Run.Timeout)
+		defer cancel()
+	}
+
+	// The linters accumulate the issues of their runs: they can't be reused.
+	dbManager, err := lintersdb.NewManager(logger.Child(logutils.DebugKeyLintersDB), cfg,
+		lintersdb.NewLinterBuilder(), lintersdb.NewPluginModuleBuilder(logger), lintersdb.NewPluginGoBuilder(logger),
+		lintersdb.NewPluginCommandBuilder(logger), lintersdb.NewPluginVetToolBuilder(logger))
+	if err != nil {
+		return nil, err
+	}
+
+	lintersToRun, err := dbManager.GetOptimizedLinters()
+	if err != nil {
+		return nil, err
+	}
+
+	lintersToLoad, err := dbManager.GetLintersToLoad()
+	if err != nil {
+		return nil, err
+	}
+
+	guard := load.NewGuard()
+
+	pkgLoader := lint.NewPackageLoader(logger.Child(logutils.DebugKeyLoader), cfg, req.Args, c.goenv, guard)
+	pkgLoader.SetPackagesCache(c.pkgsCache)
+
+	contextBuilder := lint.NewContextBuilder(cfg, pkgLoader, c.fileCache, c.pkgCache, guard)
+
+	lintCtx, err := contextBuilder.Build(ctx, logger.Child(logutils.DebugKeyLintersContext), lintersToLoad)
+	if errors.Is(err, lint.ErrNoChangedPackages) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("context loading failed: %w", err)
+	}
+
+	// The line cache is not shared: the lines can change between the runs.
+	lineCache := fsutils.NewLineCache(c.fileCache)
+
+	runner, err := lint.NewRunner(logger.Child(logutils.DebugKeyRunner), cfg, req.Args,
+		c.goenv, lineCache, c.fileCache, dbManager, lintCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	issues, err := runner.Run(ctx, lintersToRun)
+	if err != nil {
+		return nil, err
+	}
+
+	if ctx.Err() != nil {
+		return nil, &exitcodes.ExitError{Message: "timeout exceeded: try increasing it by passing --timeout option", Code: exitcodes.Timeout}
+	}
+
+	return issues, nil
+}
+
+// loadConfig loads the configuration with the flags of the client.
+// The files of the configuration are loaded on each request: they can change between the runs.
+func (c *daemonCommand) loadConfig(req *daemon.Request) (*config.Config, error) {
+	cfg := config.NewDefault()
+
+	v := viper.New()
+
+	fs := pflag.NewFlagSet("run", pflag.ContinueOnError)
+
+	var opts config.LoaderOptions
+
+	setupConfigFileFlagSet(fs, &opts)
+
+	setupLintersFlagSet(v, fs)
+	setupRunFlagSet(v, fs)
+	setupOutputFlagSet(v, fs)
+	setupIssuesFlagSet(v, fs)
+
+	for name, values := range req.Flags {
+		// The other flags (ex: --verbose) are only used by the client.
+		f := fs.Lookup(name)
+		if f == nil {
+			continue
+		}
+
+		var err error
+
+		if value, ok := f.Value.(pflag.SliceValue); ok {
+			err = value.Replace(values)
+			f.Changed = true
+		} else if len(values) > 0 {
+			err = fs.Set(name, values[0])
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid flag %s: %w", name, err)
+		}
+	}
+
+	// The warnings of the configuration are already reported by the client.
+	loader := config.NewLoader(c.log.Child(logutils.DebugKeyConfigReader), v, fs, opts, cfg, req.Args)
+
+	err := loader.Load(config.LoadOptions{CheckDeprecation: true, Validation: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// Invalidate implements [daemon.Linter].
+func (c *daemonCommand) Invalidate(files []string) {
+	for _, path := range files {
+		resetFile(c.fileCache, path)
+	}
+
+	c.pkgCache.Invalidate(c.pkgsCache.Invalidate(files)...)
+}
+
+// Reset implements [daemon.Linter].
+func (c *daemonCommand) Reset() {
+	pkgs := c.pkgsCache.Reset()
+
+	for _, pkg := range pkgs {
+		for _, path := range slices.Concat(pkg.GoFiles, pkg.CompiledGoFiles, pkg.OtherFiles) {
+			resetFile(c.fileCache, path)
+		}
+	}
+
+	c.pkgCache.Invalidate(pkgs...)
+}
+
+var _ daemon.Linter = (*daemonCommand)(nil)
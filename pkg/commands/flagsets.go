@@ -41,6 +41,8 @@ func setupLintersFlagSet(v *viper.Viper, fs *pflag.FlagSet) {
 func setupRunFlagSet(v *viper.Viper, fs *pflag.FlagSet) {
 	internal.AddFlagAndBindP(v, fs, fs.IntP, "concurrency", "j", "run.concurrency", getDefaultConcurrency(),
 		color.GreenString("Number of CPUs to use (Default: number of logical CPUs)"))
+	internal.AddFlagAndBind(v, fs, fs.String, "memory-limit", "run.memory-limit", "",
+		color.GreenString("Soft memory limit, e.g. 6GiB: the analysis is throttled near the limit (Default: GOMEMLIMIT)"))
 
 	internal.AddFlagAndBind(v, fs, fs.String, "modules-download-mode", "run.modules-download-mode", "",
 		color.GreenString("Modules download mode. If not empty, passed as -mod=<mode> to go tools"))
@@ -50,8 +52,13 @@ func setupRunFlagSet(v *viper.Viper, fs *pflag.FlagSet) {
 	internal.AddHackedStringSlice(fs, "build-tags", color.GreenString("Build tags"))
 
 	internal.AddFlagAndBind(v, fs, fs.Duration, "timeout", "run.timeout", defaultTimeout, color.GreenString("Timeout for total work"))
+	internal.AddFlagAndBind(v, fs, fs.Bool, "timeout-partial-results", "run.timeout-partial-results", false,
+		color.GreenString("Print the issues of the packages analyzed before the timeout (the results are marked as incomplete)"))
 
 	internal.AddFlagAndBind(v, fs, fs.Bool, "tests", "run.tests", true, color.GreenString("Analyze tests (*_test.go)"))
+	internal.AddFlagAndBind(v, fs, fs.String, "changed-since", "run.changed-since", "",
+		color.GreenString("Analyze only the packages changed since git revision `REV` (and their dependents if needed), "+
+			"and show only the new issues"))
 
 	internal.AddDeprecatedHackedStringSlice(fs, "skip-files", color.GreenString("Regexps of files to skip"))
 	internal.AddDeprecatedHackedStringSlice(fs, "skip-dirs", color.GreenString("Regexps of directories to skip"))
@@ -90,6 +97,8 @@ func setupIssuesFlagSet(v *viper.Viper, fs *pflag.FlagSet) {
 	internal.AddHackedStringSliceP(fs, "exclude", "e", color.GreenString("Exclude issue by regexp"))
 	internal.AddFlagAndBind(v, fs, fs.Bool, "exclude-use-default", "issues.exclude-use-default", true,
 		getDefaultIssueExcludeHelp())
+	internal.AddFlagAndBind(v, fs, fs.Bool, "equivalents-use-default", "issues.equivalents-use-default", true,
+		getDefaultEquivalentsHelp())
 	internal.AddFlagAndBind(v, fs, fs.Bool, "exclude-case-sensitive", "issues.exclude-case-sensitive", false,
 		color.GreenString("If set to true exclude and exclude rules regular expressions are case-sensitive"))
 
@@ -119,8 +128,16 @@ func setupIssuesFlagSet(v *viper.Viper, fs *pflag.FlagSet) {
 		color.GreenString("Show only new issues created in git patch with file path `PATH`"))
 	internal.AddFlagAndBind(v, fs, fs.Bool, "whole-files", "issues.whole-files", false,
 		color.GreenString("Show issues in any part of update files (requires new-from-rev or new-from-patch)"))
+	internal.AddFlagAndBind(v, fs, fs.String, "baseline", "issues.baseline", "",
+		color.GreenString("Hide the issues recorded in the baseline file `PATH`"))
+	internal.AddFlagAndBind(v, fs, fs.String, "baseline-write", "issues.baseline-write", "",
+		color.GreenString("Record all the current issues in the baseline file `PATH`"))
 	internal.AddFlagAndBind(v, fs, fs.Bool, "fix", "issues.fix", false,
 		color.GreenString("Fix found issues (if it's supported by the linter)"))
+	internal.AddFlagAndBind(v, fs, fs.Bool, "diff", "issues.fix-diff", false,
+		color.GreenString("Print the fixes as a unified diff instead of modifying the files (requires fix)"))
+	internal.AddFlagAndBind(v, fs, fs.String, "diff-path", "issues.fix-diff-path", "",
+		color.GreenString("Write the diff of the fixes to the file `PATH` instead of stdout (requires diff)"))
 }
 
 func getDefaultIssueExcludeHelp() string {
@@ -136,6 +153,16 @@ func getDefaultIssueExcludeHelp() string {
 	return strings.Join(parts, "\n")
 }
 
+func getDefaultEquivalentsHelp() string {
+	parts := []string{color.GreenString("Use or not use default equivalents (the issues of the first source are kept):")}
+
+	for _, sources := range config.DefaultEquivalents {
+		parts = append(parts, fmt.Sprintf("  - %s", color.CyanString(strings.Join(sources, ", "))))
+	}
+
+	return strings.Join(parts, "\n")
+}
+
 func getDefaultDirectoryExcludeHelp() string {
 	parts := []string{color.GreenString("Use or not use default excluded directories:")}
 	for _, dir := range processors.StdExcludeDirRegexps {
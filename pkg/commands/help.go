@@ -8,6 +8,7 @@ import (
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/lint/linter"
@@ -15,8 +16,15 @@ import (
 	"github.com/golangci/golangci-lint/pkg/logutils"
 )
 
+type helpOptions struct {
+	config.LoaderOptions
+}
+
 type helpCommand struct {
-	cmd *cobra.Command
+	viper *viper.Viper
+	cmd   *cobra.Command
+
+	opts helpOptions
 
 	dbManager *lintersdb.Manager
 
@@ -24,7 +32,10 @@ type helpCommand struct {
 }
 
 func newHelpCommand(logger logutils.Log) *helpCommand {
-	c := &helpCommand{log: logger}
+	c := &helpCommand{
+		viper: viper.New(),
+		log:   logger,
+	}
 
 	helpCmd := &cobra.Command{
 		Use:   "help",
@@ -35,26 +46,52 @@ func newHelpCommand(logger logutils.Log) *helpCommand {
 		},
 	}
 
-	helpCmd.AddCommand(
-		&cobra.Command{
-			Use:               "linters",
-			Short:             "Help about linters",
-			Args:              cobra.NoArgs,
-			ValidArgsFunction: cobra.NoFileCompletions,
-			Run:               c.execute,
-			PreRunE:           c.preRunE,
-		},
-	)
+	lintersCmd := &cobra.Command{
+		Use:               "linters",
+		Short:             "Help about linters",
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		Run:               c.execute,
+		PreRunE:           c.preRunE,
+	}
+
+	fs := lintersCmd.Flags()
+	fs.SortFlags = false // sort them as they are defined here
+
+	setupConfigFileFlagSet(fs, &c.opts.LoaderOptions)
+
+	helpCmd.AddCommand(lintersCmd)
 
 	c.cmd = helpCmd
 
 	return c
 }
 
-func (c *helpCommand) preRunE(_ *cobra.Command, _ []string) error {
-	// The command doesn't depend on the real configuration.
-	// It just needs the list of all plugins and all presets.
-	dbManager, err := lintersdb.NewManager(c.log.Child(logutils.DebugKeyLintersDB), config.NewDefault(), lintersdb.NewLinterBuilder())
+func (c *helpCommand) preRunE(cmd *cobra.Command, args []string) error {
+	// The enabled linters of the configuration are ignored:
+	// the configuration is only used to load the custom linters.
+	cfg := config.NewDefault()
+
+	loader := config.NewLoader(c.log.Child(logutils.DebugKeyConfigReader), c.viper, cmd.Flags(), c.opts.LoaderOptions, cfg, args)
+
+	// The configuration is not validated: an invalid configuration must not prevent printing the linters.
+	err := loader.Load(config.LoadOptions{})
+	if err != nil {
+		return fmt.Errorf("can't load config: %w", err)
+	}
+
+	// Only the settings of the custom linters are kept: the other linters are built with the default settings.
+	custom := cfg.LintersSettings.Custom
+
+	defaultCfg := config.NewDefault()
+
+	cfg.Linters = defaultCfg.Linters
+	cfg.LintersSettings = defaultCfg.LintersSettings
+	cfg.LintersSettings.Custom = custom
+
+	dbManager, err := lintersdb.NewManager(c.log.Child(logutils.DebugKeyLintersDB), cfg,
+		lintersdb.NewLinterBuilder(), lintersdb.NewPluginModuleBuilder(c.log), lintersdb.NewPluginGoBuilder(c.log),
+		lintersdb.NewPluginCommandBuilder(c.log), lintersdb.NewPluginVetToolBuilder(c.log))
 	if err != nil {
 		return err
 	}
@@ -124,12 +161,7 @@ func printLinters(lcs []*linter.Config) {
 	})
 
 	for _, lc := range lcs {
-		// If the linter description spans multiple lines, truncate everything following the first newline
-		linterDescription := lc.Linter.Desc()
-		firstNewline := strings.IndexRune(linterDescription, '\n')
-		if firstNewline > 0 {
-			linterDescription = linterDescription[:firstNewline]
-		}
+		linterDescription := firstLine(lc.Linter.Desc())
 
 		deprecatedMark := ""
 		if lc.IsDeprecated() {
@@ -138,5 +170,19 @@ func printLinters(lcs []*linter.Config) {
 
 		_, _ = fmt.Fprintf(logutils.StdOut, "%s%s: %s [fast: %t, auto-fix: %t]\n",
 			color.YellowString(lc.Name()), deprecatedMark, linterDescription, !lc.IsSlowLinter(), lc.CanAutoFix)
+
+		for _, doc := range lc.AnalyzersDocs {
+			_, _ = fmt.Fprintf(logutils.StdOut, "  - %s: %s\n", color.CyanString(doc.Name), firstLine(doc.Doc))
+		}
+	}
+}
+
+// firstLine truncates everything following the first newline, if the text spans multiple lines.
+func firstLine(text string) string {
+	firstNewline := strings.IndexRune(text, '\n')
+	if firstNewline > 0 {
+		return text[:firstNewline]
 	}
+
+	return text
 }
@@ -2,12 +2,14 @@ package internal
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"strings"
 	"time"
 	"unicode"
@@ -23,25 +25,32 @@ type Builder struct {
 
 	root string
 	repo string
+
+	offline bool
 }
 
 // NewBuilder creates a new Builder.
-func NewBuilder(logger logutils.Log, cfg *Configuration, root string) *Builder {
+// In offline mode, the modules are only resolved from the module cache.
+func NewBuilder(logger logutils.Log, cfg *Configuration, root string, offline bool) *Builder {
 	return &Builder{
-		cfg:  cfg,
-		log:  logger,
-		root: root,
-		repo: filepath.Join(root, "golangci-lint"),
+		cfg:     cfg,
+		log:     logger,
+		root:    root,
+		repo:    filepath.Join(root, "golangci-lint"),
+		offline: offline || os.Getenv("GOPROXY") == "off",
 	}
 }
 
 // Build builds the custom binary.
 func (b Builder) Build(ctx context.Context) error {
-	b.log.Infof("Cloning golangci-lint repository")
+	lock, err := LoadLock()
+	if err != nil {
+		return err
+	}
 
-	err := b.clone(ctx)
+	err = b.getSources(ctx)
 	if err != nil {
-		return fmt.Errorf("clone golangci-lint: %w", err)
+		return fmt.Errorf("get golangci-lint sources: %w", err)
 	}
 
 	b.log.Infof("Adding plugin imports")
@@ -53,7 +62,7 @@ func (b Builder) Build(ctx context.Context) error {
 
 	b.log.Infof("Adding replace directives")
 
-	err = b.addToGoMod(ctx)
+	err = b.addToGoMod(ctx, lock)
 	if err != nil {
 		return fmt.Errorf("add to go.mod: %w", err)
 	}
@@ -65,11 +74,18 @@ func (b Builder) Build(ctx context.Context) error {
 		return fmt.Errorf("go mod tidy: %w", err)
 	}
 
+	b.log.Infof("Resolving plugin versions")
+
+	locked, err := b.resolvePlugins(ctx, lock)
+	if err != nil {
+		return fmt.Errorf("resolve plugins: %w", err)
+	}
+
 	b.log.Infof("Building golangci-lint binary")
 
 	binaryName := b.getBinaryName()
 
-	err = b.goBuild(ctx, binaryName)
+	err = b.goBuild(ctx, binaryName, locked)
 	if err != nil {
 		return fmt.Errorf("build golangci-lint binary: %w", err)
 	}
@@ -81,29 +97,17 @@ func (b Builder) Build(ctx context.Context) error {
 		return fmt.Errorf("move golangci-lint binary: %w", err)
 	}
 
-	return nil
-}
-
-func (b Builder) clone(ctx context.Context) error {
-	//nolint:gosec // the variable is sanitized.
-	cmd := exec.CommandContext(ctx,
-		"git", "clone", "--branch", sanitizeVersion(b.cfg.Version),
-		"--single-branch", "--depth", "1", "-c advice.detachedHead=false", "-q",
-		"https://github.com/golangci/golangci-lint.git",
-	)
-	cmd.Dir = b.root
+	b.log.Infof("Writing lock file")
 
-	output, err := cmd.CombinedOutput()
+	err = (&Lock{Plugins: locked}).Save()
 	if err != nil {
-		b.log.Infof("%s", string(output))
-
-		return fmt.Errorf("%s: %w", strings.Join(cmd.Args, " "), err)
+		return fmt.Errorf("write lock file: %w", err)
 	}
 
 	return nil
 }
 
-func (b Builder) addToGoMod(ctx context.Context) error {
+func (b Builder) addToGoMod(ctx context.Context, lock *Lock) error {
 	for _, plugin := range b.cfg.Plugins {
 		if plugin.Path != "" {
 			err := b.addReplaceDirective(ctx, plugin)
@@ -114,7 +118,13 @@ func (b Builder) addToGoMod(ctx context.Context) error {
 			continue
 		}
 
-		err := b.goGet(ctx, plugin)
+		version := plugin.Version
+
+		if locked := lock.find(plugin); locked != nil {
+			version = locked.Version
+		}
+
+		err := b.goGet(ctx, plugin.Module, version)
 		if err != nil {
 			return err
 		}
@@ -123,10 +133,11 @@ func (b Builder) addToGoMod(ctx context.Context) error {
 	return nil
 }
 
-func (b Builder) goGet(ctx context.Context, plugin *Plugin) error {
+func (b Builder) goGet(ctx context.Context, module, version string) error {
 	//nolint:gosec // the variables are user related.
-	cmd := exec.CommandContext(ctx, "go", "get", plugin.Module+"@"+plugin.Version)
+	cmd := exec.CommandContext(ctx, "go", "get", module+"@"+version)
 	cmd.Dir = b.repo
+	cmd.Env = b.env()
 
 	b.log.Infof("run: %s", strings.Join(cmd.Args, " "))
 
@@ -145,6 +156,7 @@ func (b Builder) addReplaceDirective(ctx context.Context, plugin *Plugin) error
 
 	cmd := exec.CommandContext(ctx, "go", "mod", "edit", "-replace", replace)
 	cmd.Dir = b.repo
+	cmd.Env = b.env()
 
 	b.log.Infof("run: %s", strings.Join(cmd.Args, " "))
 
@@ -161,6 +173,7 @@ func (b Builder) addReplaceDirective(ctx context.Context, plugin *Plugin) error
 func (b Builder) goModTidy(ctx context.Context) error {
 	cmd := exec.CommandContext(ctx, "go", "mod", "tidy")
 	cmd.Dir = b.repo
+	cmd.Env = b.env()
 
 	output, err := cmd.CombinedOutput()
 	if err != nil {
@@ -172,18 +185,78 @@ func (b Builder) goModTidy(ctx context.Context) error {
 	return nil
 }
 
-func (b Builder) goBuild(ctx context.Context, binaryName string) error {
+// resolvePlugins returns the versions of the plugins resolved by `go mod tidy`.
+// The checksum of a locked version must not change.
+func (b Builder) resolvePlugins(ctx context.Context, lock *Lock) ([]*LockedPlugin, error) {
+	var resolved []*LockedPlugin
+
+	for _, plugin := range b.cfg.Plugins {
+		if plugin.Path != "" {
+			continue
+		}
+
+		//nolint:gosec // the variable is user related.
+		cmd := exec.CommandContext(ctx, "go", "list", "-m", "-json", plugin.Module)
+		cmd.Dir = b.repo
+		cmd.Env = b.env()
+
+		output, err := cmd.Output()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", strings.Join(cmd.Args, " "), err)
+		}
+
+		var mod struct {
+			Version string
+			Sum     string
+		}
+
+		err = json.Unmarshal(output, &mod)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", strings.Join(cmd.Args, " "), err)
+		}
+
+		locked := lock.find(plugin)
+		if locked != nil && locked.Version == mod.Version && locked.Sum != mod.Sum {
+			return nil, fmt.Errorf("checksum mismatch for %s@%s: locked %s, resolved %s", plugin.Module, mod.Version, locked.Sum, mod.Sum)
+		}
+
+		resolved = append(resolved, &LockedPlugin{
+			Module:  plugin.Module,
+			Query:   plugin.Version,
+			Version: mod.Version,
+			Sum:     mod.Sum,
+		})
+	}
+
+	return resolved, nil
+}
+
+func (b Builder) goBuild(ctx context.Context, binaryName string, locked []*LockedPlugin) error {
+	plugins, err := pluginsInfo(b.cfg, locked)
+	if err != nil {
+		return err
+	}
+
+	date, err := b.buildDate(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The binary is reproducible: the paths of the build, the VCS information, and the current date are not embedded.
 	//nolint:gosec // the variable is sanitized.
 	cmd := exec.CommandContext(ctx, "go", "build",
+		"-trimpath",
+		"-buildvcs=false",
 		"-ldflags",
 		fmt.Sprintf(
-			"-s -w -X 'main.version=%s-custom-gcl' -X 'main.date=%s'",
-			sanitizeVersion(b.cfg.Version), time.Now().UTC().String(),
+			"-s -w -X 'main.version=%s-custom-gcl' -X 'main.date=%s' -X 'main.plugins=%s'",
+			sanitizeVersion(b.cfg.Version), date.Format(time.RFC3339), plugins,
 		),
 		"-o", binaryName,
 		"./cmd/golangci-lint",
 	)
 	cmd.Dir = b.repo
+	cmd.Env = b.env()
 
 	output, err := cmd.CombinedOutput()
 	if err != nil {
@@ -195,6 +268,29 @@ func (b Builder) goBuild(ctx context.Context, binaryName string) error {
 	return nil
 }
 
+// buildDate returns the date embedded inside the binary:
+// the date defined by SOURCE_DATE_EPOCH (https://reproducible-builds.org/specs/source-date-epoch/),
+// or the date of the golangci-lint sources.
+func (b Builder) buildDate(ctx context.Context) (time.Time, error) {
+	if epoch := os.Getenv("SOURCE_DATE_EPOCH"); epoch != "" {
+		seconds, err := strconv.ParseInt(epoch, 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid SOURCE_DATE_EPOCH: %w", err)
+		}
+
+		return time.Unix(seconds, 0).UTC(), nil
+	}
+
+	date, err := b.sourcesDate(ctx)
+	if err != nil {
+		b.log.Warnf("Can't get the date of the golangci-lint sources (the Unix epoch is used): %v", err)
+
+		return time.Unix(0, 0).UTC(), nil
+	}
+
+	return date.UTC(), nil
+}
+
 func (b Builder) copyBinary(binaryName string) error {
 	src := filepath.Join(b.repo, binaryName)
 
@@ -241,6 +337,56 @@ func (b Builder) getBinaryName() string {
 	return name
 }
 
+// env returns the environment of the go commands.
+// In offline mode, the modules are resolved from the module cache only.
+func (b Builder) env() []string {
+	if !b.offline {
+		return nil
+	}
+
+	env := append(os.Environ(), "GOPROXY=off")
+
+	goflags := os.Getenv("GOFLAGS")
+	if !strings.Contains(goflags, "-mod=") {
+		env = append(env, strings.TrimSpace("GOFLAGS="+goflags+" -mod=mod"))
+	}
+
+	return env
+}
+
+// pluginsInfo returns the list of the plugins embedded inside the binary (JSON).
+// The single quotes are escaped because the value is single-quoted inside the ldflags.
+func pluginsInfo(cfg *Configuration, locked []*LockedPlugin) (string, error) {
+	type pluginInfo struct {
+		Module  string `json:"module"`
+		Version string `json:"version,omitempty"`
+		Sum     string `json:"sum,omitempty"`
+		Path    string `json:"path,omitempty"`
+	}
+
+	var infos []pluginInfo
+
+	for _, plugin := range cfg.Plugins {
+		info := pluginInfo{Module: plugin.Module, Path: plugin.Path}
+
+		for _, l := range locked {
+			if l.Module == plugin.Module {
+				info.Version = l.Version
+				info.Sum = l.Sum
+			}
+		}
+
+		infos = append(infos, info)
+	}
+
+	data, err := json.Marshal(infos)
+	if err != nil {
+		return "", fmt.Errorf("plugins information: %w", err)
+	}
+
+	return strings.ReplaceAll(string(data), "'", `\u0027`), nil
+}
+
 func sanitizeVersion(v string) string {
 	fn := func(c rune) bool {
 		return !(unicode.IsLetter(c) || unicode.IsNumber(c) || c == '.' || c == '/')
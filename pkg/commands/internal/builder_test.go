@@ -1,9 +1,16 @@
 package internal
 
 import (
+	"context"
+	"os"
+	"os/exec"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
 )
 
 func Test_sanitizeVersion(t *testing.T) {
@@ -54,3 +61,92 @@ func Test_sanitizeVersion(t *testing.T) {
 		})
 	}
 }
+
+func Test_pluginsInfo(t *testing.T) {
+	cfg := &Configuration{
+		Version: "v1.57.0",
+		Plugins: []*Plugin{
+			{
+				Module:  "example.org/foo/bar",
+				Version: "latest",
+			},
+			{
+				Module: "example.com/foo/bar",
+				Path:   "/my/path/it's",
+			},
+		},
+	}
+
+	locked := []*LockedPlugin{
+		{
+			Module:  "example.org/foo/bar",
+			Query:   "latest",
+			Version: "v1.2.3",
+			Sum:     "h1:abc=",
+		},
+	}
+
+	info, err := pluginsInfo(cfg, locked)
+	require.NoError(t, err)
+
+	expected := `[{"module":"example.org/foo/bar","version":"v1.2.3","sum":"h1:abc="},{"module":"example.com/foo/bar","path":"/my/path/it\u0027s"}]`
+
+	assert.Equal(t, expected, info)
+}
+
+func TestBuilder_buildDate(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		epoch    string
+		expected time.Time
+	}{
+		{
+			desc:     "SOURCE_DATE_EPOCH",
+			epoch:    "1700000000",
+			expected: time.Date(2023, time.November, 14, 22, 13, 20, 0, time.UTC),
+		},
+		{
+			desc:     "commit date",
+			expected: time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Setenv("SOURCE_DATE_EPOCH", test.epoch)
+
+			dir := t.TempDir()
+
+			runGit(t, dir, "init", "-q")
+			runGit(t, dir, "-c", "user.name=test", "-c", "user.email=test@example.com",
+				"commit", "-q", "--allow-empty", "-m", "init")
+
+			b := NewBuilder(logutils.NewStderrLog(logutils.DebugKeyEmpty), &Configuration{Path: dir}, t.TempDir(), false)
+
+			date, err := b.buildDate(context.Background())
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, date)
+		})
+	}
+}
+
+func TestBuilder_buildDate_error(t *testing.T) {
+	t.Setenv("SOURCE_DATE_EPOCH", "yesterday")
+
+	b := NewBuilder(logutils.NewStderrLog(logutils.DebugKeyEmpty), &Configuration{}, t.TempDir(), false)
+
+	_, err := b.buildDate(context.Background())
+	require.Error(t, err)
+}
+
+func runGit(t *testing.T, dir string, args ...string) {
+	t.Helper()
+
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GIT_COMMITTER_DATE=2024-03-01T10:00:00Z", "GIT_AUTHOR_DATE=2024-03-01T10:00:00Z")
+
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(output))
+}
@@ -19,6 +19,11 @@ type Configuration struct {
 	// golangci-lint version.
 	Version string `yaml:"version"`
 
+	// Path to the local sources of golangci-lint.
+	// Optional: by default, the sources are cloned from the golangci-lint repository,
+	// or copied from the module cache in offline mode.
+	Path string `yaml:"path,omitempty"`
+
 	// Name of the binary.
 	Name string `yaml:"name,omitempty"`
 
@@ -39,6 +44,15 @@ func (c *Configuration) Validate() error {
 		c.Name = defaultBinaryName
 	}
 
+	if strings.TrimSpace(c.Path) != "" {
+		abs, err := filepath.Abs(c.Path)
+		if err != nil {
+			return err
+		}
+
+		c.Path = abs
+	}
+
 	if len(c.Plugins) == 0 {
 		return errors.New("no plugins defined")
 	}
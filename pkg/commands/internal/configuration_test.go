@@ -38,6 +38,19 @@ func TestConfiguration_Validate(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "golangci-lint path",
+			cfg: &Configuration{
+				Version: "v1.57.0",
+				Path:    "/my/golangci-lint",
+				Plugins: []*Plugin{
+					{
+						Module:  "example.org/foo/bar",
+						Version: "v1.2.3",
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range testCases {
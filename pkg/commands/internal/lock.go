@@ -0,0 +1,91 @@
+package internal
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+const lockFile = base + ".lock"
+
+const lockFileMode = 0o644
+
+const lockHeader = "# This file is generated by `golangci-lint custom`: do not edit.\n"
+
+// Lock represents the lock file: the versions of the plugins resolved by a build.
+type Lock struct {
+	Plugins []*LockedPlugin `yaml:"plugins,omitempty"`
+}
+
+// LockedPlugin represents the resolved version of a plugin.
+type LockedPlugin struct {
+	// Module name.
+	Module string `yaml:"module"`
+
+	// Version of the configuration.
+	// It can be a version query (ex: `latest`, a branch).
+	Query string `yaml:"query"`
+
+	// Resolved version of the module.
+	Version string `yaml:"version"`
+
+	// Checksum of the module (as in `go.sum`).
+	Sum string `yaml:"sum"`
+}
+
+// LoadLock loads the lock file, if it exists.
+func LoadLock() (*Lock, error) {
+	data, err := os.ReadFile(lockFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &Lock{}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("file %s read: %w", lockFile, err)
+	}
+
+	var lock Lock
+
+	err = yaml.Unmarshal(data, &lock)
+	if err != nil {
+		return nil, fmt.Errorf("file %s YAML decoding: %w", lockFile, err)
+	}
+
+	return &lock, nil
+}
+
+// Save writes the lock file.
+func (l *Lock) Save() error {
+	buf := bytes.NewBufferString(lockHeader)
+
+	encoder := yaml.NewEncoder(buf)
+	encoder.SetIndent(2)
+
+	err := encoder.Encode(l)
+	if err != nil {
+		return fmt.Errorf("YAML encoding: %w", err)
+	}
+
+	err = os.WriteFile(lockFile, buf.Bytes(), lockFileMode)
+	if err != nil {
+		return fmt.Errorf("file %s write: %w", lockFile, err)
+	}
+
+	return nil
+}
+
+// find returns the locked version of the plugin,
+// only if the version of the configuration has not changed since the lock.
+func (l *Lock) find(plugin *Plugin) *LockedPlugin {
+	for _, locked := range l.Plugins {
+		if locked.Module == plugin.Module && locked.Query == plugin.Version {
+			return locked
+		}
+	}
+
+	return nil
+}
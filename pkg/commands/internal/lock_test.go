@@ -0,0 +1,48 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLock_find(t *testing.T) {
+	lock := &Lock{
+		Plugins: []*LockedPlugin{
+			{
+				Module:  "example.org/foo/bar",
+				Query:   "latest",
+				Version: "v1.2.3",
+				Sum:     "h1:abc=",
+			},
+		},
+	}
+
+	testCases := []struct {
+		desc     string
+		plugin   *Plugin
+		expected *LockedPlugin
+	}{
+		{
+			desc:     "locked",
+			plugin:   &Plugin{Module: "example.org/foo/bar", Version: "latest"},
+			expected: lock.Plugins[0],
+		},
+		{
+			desc:   "version changed",
+			plugin: &Plugin{Module: "example.org/foo/bar", Version: "v1.3.0"},
+		},
+		{
+			desc:   "not locked",
+			plugin: &Plugin{Module: "example.com/foo/bar", Version: "latest"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, lock.find(test.plugin))
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const golangciModule = "github.com/golangci/golangci-lint"
+
+const ownerWritePerm = 0o200
+
+func (b Builder) getSources(ctx context.Context) error {
+	switch {
+	case b.cfg.Path != "":
+		b.log.Infof("Copying golangci-lint sources from %s", b.cfg.Path)
+
+		return copySources(b.cfg.Path, b.repo)
+
+	case b.offline:
+		b.log.Infof("Copying golangci-lint sources from the module cache")
+
+		mod, err := b.downloadModule(ctx)
+		if err != nil {
+			return err
+		}
+
+		return copySources(mod.Dir, b.repo)
+
+	default:
+		b.log.Infof("Cloning golangci-lint repository")
+
+		return b.clone(ctx)
+	}
+}
+
+// sourcesDate returns the date of the golangci-lint sources:
+// the date of the last commit of the repository, or the date of the version of the module.
+func (b Builder) sourcesDate(ctx context.Context) (time.Time, error) {
+	switch {
+	case b.cfg.Path != "":
+		return commitDate(ctx, b.cfg.Path)
+
+	case b.offline:
+		mod, err := b.downloadModule(ctx)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		return mod.Time, nil
+
+	default:
+		return commitDate(ctx, b.repo)
+	}
+}
+
+// commitDate returns the date of the last commit of the git repository containing the directory.
+func commitDate(ctx context.Context, dir string) (time.Time, error) {
+	cmd := exec.CommandContext(ctx, "git", "log", "-1", "--format=%cI")
+	cmd.Dir = dir
+
+	output, err := cmd.Output()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s: %w", strings.Join(cmd.Args, " "), err)
+	}
+
+	return time.Parse(time.RFC3339, strings.TrimSpace(string(output)))
+}
+
+func (b Builder) clone(ctx context.Context) error {
+	//nolint:gosec // the variable is sanitized.
+	cmd := exec.CommandContext(ctx,
+		"git", "clone", "--branch", sanitizeVersion(b.cfg.Version),
+		"--single-branch", "--depth", "1", "-c advice.detachedHead=false", "-q",
+		"https://github.com/golangci/golangci-lint.git",
+	)
+	cmd.Dir = b.root
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		b.log.Infof("%s", string(output))
+
+		return fmt.Errorf("%s: %w", strings.Join(cmd.Args, " "), err)
+	}
+
+	return nil
+}
+
+// downloadedModule is the golangci-lint module inside the module cache.
+type downloadedModule struct {
+	Dir   string
+	Time  time.Time
+	Error string
+}
+
+// downloadModule returns the golangci-lint module inside the module cache.
+// With GOPROXY=off, the module must already be inside the module cache.
+func (b Builder) downloadModule(ctx context.Context) (*downloadedModule, error) {
+	//nolint:gosec // the variable is sanitized.
+	cmd := exec.CommandContext(ctx, "go", "mod", "download", "-json", golangciModule+"@"+sanitizeVersion(b.cfg.Version))
+	cmd.Dir = b.root
+	cmd.Env = b.env()
+
+	b.log.Infof("run: %s", strings.Join(cmd.Args, " "))
+
+	stdout := new(bytes.Buffer)
+	cmd.Stdout = stdout
+
+	runErr := cmd.Run()
+
+	// The output contains the error of the download, even when the command fails.
+	mod := &downloadedModule{}
+
+	err := json.Unmarshal(stdout.Bytes(), mod)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("%s: %w", strings.Join(cmd.Args, " "), runErr), err)
+	}
+
+	if mod.Error != "" {
+		return nil, fmt.Errorf("%s: %s", strings.Join(cmd.Args, " "), mod.Error)
+	}
+
+	if runErr != nil {
+		return nil, fmt.Errorf("%s: %w", strings.Join(cmd.Args, " "), runErr)
+	}
+
+	return mod, nil
+}
+
+// copySources copies the files of the module in src to dst.
+// Like the go command, the directories starting with `.` or `_`, the `testdata` directories, and the nested modules are ignored.
+func copySources(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(dst, rel)
+
+		if d.IsDir() {
+			if rel != "." && isIgnoredDir(path, d.Name()) {
+				return filepath.SkipDir
+			}
+
+			return os.MkdirAll(target, os.ModePerm)
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		return copyFile(path, target)
+	})
+}
+
+func isIgnoredDir(path, name string) bool {
+	if strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") || name == "testdata" {
+		return true
+	}
+
+	_, err := os.Stat(filepath.Join(path, "go.mod"))
+
+	return err == nil
+}
+
+func copyFile(src, dst string) error {
+	source, err := os.Open(filepath.Clean(src))
+	if err != nil {
+		return fmt.Errorf("open source file: %w", err)
+	}
+
+	defer func() { _ = source.Close() }()
+
+	info, err := source.Stat()
+	if err != nil {
+		return fmt.Errorf("stat source file: %w", err)
+	}
+
+	// The files of the module cache are read-only: some of them are modified by the build.
+	dest, err := os.OpenFile(filepath.Clean(dst), os.O_RDWR|os.O_CREATE|os.O_TRUNC, info.Mode().Perm()|ownerWritePerm)
+	if err != nil {
+		return fmt.Errorf("create destination file: %w", err)
+	}
+
+	defer func() { _ = dest.Close() }()
+
+	_, err = io.Copy(dest, source)
+	if err != nil {
+		return fmt.Errorf("copy %s: %w", src, err)
+	}
+
+	return nil
+}
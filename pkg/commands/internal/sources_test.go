@@ -0,0 +1,64 @@
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_copySources(t *testing.T) {
+	src := t.TempDir()
+
+	files := map[string]string{
+		"go.mod":                     "module example.com/foo",
+		"cmd/foo/main.go":            "package main",
+		"cmd/foo/plugins.go":         "package main",
+		".git/config":                "",
+		"_tools/tools.go":            "package tools",
+		"pkg/testdata/sample.go":     "package sample",
+		"scripts/gen/go.mod":         "module example.com/foo/scripts/gen",
+		"scripts/gen/main.go":        "package main",
+		"scripts/generate_readme.sh": "#!/bin/sh",
+	}
+
+	for name, content := range files {
+		path := filepath.Join(src, filepath.FromSlash(name))
+
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), os.ModePerm))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o400))
+	}
+
+	dst := t.TempDir()
+
+	err := copySources(src, dst)
+	require.NoError(t, err)
+
+	var copied []string
+
+	err = filepath.WalkDir(dst, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		rel, err := filepath.Rel(dst, path)
+		if err != nil {
+			return err
+		}
+
+		copied = append(copied, filepath.ToSlash(rel))
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	expected := []string{"cmd/foo/main.go", "cmd/foo/plugins.go", "go.mod", "scripts/generate_readme.sh"}
+
+	assert.Equal(t, expected, copied)
+
+	// The copied files are writable.
+	err = os.WriteFile(filepath.Join(dst, "cmd", "foo", "plugins.go"), []byte("package main\n"), 0o600)
+	require.NoError(t, err)
+}
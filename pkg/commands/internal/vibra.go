@@ -5,6 +5,8 @@ import (
 
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
+
+	"github.com/golangci/golangci-lint/pkg/config"
 )
 
 type FlagFunc[T any] func(name string, value T, usage string) *T
@@ -15,20 +17,14 @@ type FlagPFunc[T any] func(name, shorthand string, value T, usage string) *T
 func AddFlagAndBind[T any](v *viper.Viper, fs *pflag.FlagSet, pfn FlagFunc[T], name, bind string, value T, usage string) {
 	pfn(name, value, usage)
 
-	err := v.BindPFlag(bind, fs.Lookup(name))
-	if err != nil {
-		panic(fmt.Sprintf("failed to bind flag %s: %v", name, err))
-	}
+	bindFlag(v, fs, name, bind)
 }
 
 // AddFlagAndBindP adds a Cobra/pflag flag and binds it with Viper.
 func AddFlagAndBindP[T any](v *viper.Viper, fs *pflag.FlagSet, pfn FlagPFunc[T], name, shorthand, bind string, value T, usage string) {
 	pfn(name, shorthand, value, usage)
 
-	err := v.BindPFlag(bind, fs.Lookup(name))
-	if err != nil {
-		panic(fmt.Sprintf("failed to bind flag %s: %v", name, err))
-	}
+	bindFlag(v, fs, name, bind)
 }
 
 // AddDeprecatedFlagAndBind similar to AddFlagAndBind but deprecate the flag.
@@ -53,6 +49,17 @@ func AddDeprecatedHackedStringSlice(fs *pflag.FlagSet, name, usage string) {
 	deprecateFlag(fs, name)
 }
 
+// bindFlag binds a flag with Viper.
+// The key is kept in an annotation of the flag: it's used to find the values set by the flags.
+func bindFlag(v *viper.Viper, fs *pflag.FlagSet, name, bind string) {
+	err := v.BindPFlag(bind, fs.Lookup(name))
+	if err != nil {
+		panic(fmt.Sprintf("failed to bind flag %s: %v", name, err))
+	}
+
+	_ = fs.SetAnnotation(name, config.FlagKeyAnnotation, []string{bind})
+}
+
 func deprecateFlag(fs *pflag.FlagSet, name string) {
 	_ = fs.MarkHidden(name)
 	_ = fs.MarkDeprecated(name, "check the documentation for more information.")
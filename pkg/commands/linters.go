@@ -67,7 +67,8 @@ func (c *lintersCommand) preRunE(cmd *cobra.Command, args []string) error {
 	}
 
 	dbManager, err := lintersdb.NewManager(c.log.Child(logutils.DebugKeyLintersDB), c.cfg,
-		lintersdb.NewLinterBuilder(), lintersdb.NewPluginModuleBuilder(c.log), lintersdb.NewPluginGoBuilder(c.log))
+		lintersdb.NewLinterBuilder(), lintersdb.NewPluginModuleBuilder(c.log), lintersdb.NewPluginGoBuilder(c.log),
+		lintersdb.NewPluginCommandBuilder(c.log), lintersdb.NewPluginVetToolBuilder(c.log))
 	if err != nil {
 		return err
 	}
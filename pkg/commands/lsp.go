@@ -0,0 +1,362 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/internal/cache"
+	"github.com/golangci/golangci-lint/internal/pkgcache"
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/goanalysis/load"
+	"github.com/golangci/golangci-lint/pkg/goutil"
+	"github.com/golangci/golangci-lint/pkg/lint"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/lint/lintersdb"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/lsp"
+	"github.com/golangci/golangci-lint/pkg/result"
+	"github.com/golangci/golangci-lint/pkg/timeutils"
+)
+
+type lspOptions struct {
+	config.LoaderOptions
+}
+
+// lspCommand runs a language server on the standard input and output.
+// The packages of the working directory, the caches, and the content of the files are kept between the runs:
+// only the packages of the modified files, and their reverse dependencies, are loaded and analyzed again.
+type lspCommand struct {
+	viper *viper.Viper
+	cmd   *cobra.Command
+
+	opts lspOptions
+
+	cfg *config.Config
+
+	buildInfo BuildInfo
+
+	log logutils.Log
+
+	goenv *goutil.Env
+
+	fileCache *fsutils.FileCache
+	pkgCache  *pkgcache.Cache
+	pkgsCache *lint.PackagesCache
+
+	// overlay contains the content of the unsaved files used by the previous analysis.
+	overlay map[string][]byte
+}
+
+func newLspCommand(logger logutils.Log, info *BuildInfo) *lspCommand {
+	c := &lspCommand{
+		viper:     viper.New(),
+		cfg:       config.NewDefault(),
+		buildInfo: *info,
+		log:       logger,
+		overlay:   map[string][]byte{},
+	}
+
+	lspCmd := &cobra.Command{
+		Use:               "lsp",
+		Short:             "Run a language server on stdin/stdout publishing the issues and their fixes",
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE:              c.execute,
+		PreRunE:           c.preRunE,
+		SilenceUsage:      true,
+	}
+
+	fs := lspCmd.Flags()
+	fs.SortFlags = false // sort them as they are defined here
+
+	setupConfigFileFlagSet(fs, &c.opts.LoaderOptions)
+
+	setupLintersFlagSet(c.viper, fs)
+	setupRunFlagSet(c.viper, fs)
+	setupOutputFlagSet(c.viper, fs)
+	setupIssuesFlagSet(c.viper, fs)
+
+	c.cmd = lspCmd
+
+	return c
+}
+
+func (c *lspCommand) preRunE(cmd *cobra.Command, args []string) error {
+	loader := config.NewLoader(c.log.Child(logutils.DebugKeyConfigReader), c.viper, cmd.Flags(), c.opts.LoaderOptions, c.cfg, args)
+
+	err := loader.Load(config.LoadOptions{Validation: true})
+	if err != nil {
+		return fmt.Errorf("can't load config: %w", err)
+	}
+
+	// The files are modified by the editor, and all the issues must be reported.
+	c.cfg.Issues.NeedFix = false
+	c.cfg.Issues.FixDiff = false
+	c.cfg.Issues.BaselineWrite = ""
+	c.cfg.Issues.MaxIssuesPerLinter = 0
+	c.cfg.Issues.MaxSameIssues = 0
+	c.cfg.Output.PathPrefix = ""
+
+	// Validates the configuration of the linters before starting the server.
+	_, err = c.newDBManager()
+	if err != nil {
+		return err
+	}
+
+	c.goenv = goutil.NewEnv(c.log.Child(logutils.DebugKeyGoEnv))
+
+	c.fileCache = fsutils.NewFileCache()
+
+	sw := timeutils.NewStopwatch("pkgcache", c.log.Child(logutils.DebugKeyStopwatch))
+
+	c.pkgCache, err = pkgcache.NewCache(sw, c.log.Child(logutils.DebugKeyPkgCache))
+	if err != nil {
+		return fmt.Errorf("failed to build packages cache: %w", err)
+	}
+
+	c.pkgsCache = lint.NewPackagesCache()
+
+	if err = initHashSalt(c.buildInfo.Version, c.cfg); err != nil {
+		return fmt.Errorf("failed to init hash salt: %w", err)
+	}
+
+	return nil
+}
+
+func (c *lspCommand) execute(_ *cobra.Command, _ []string) error {
+	ctx := context.Background()
+
+	if err := c.goenv.Discover(ctx); err != nil {
+		c.log.Warnf("Failed to discover go env: %s", err)
+	}
+
+	// The standard output is reserved to the protocol.
+	stdout := os.Stdout
+	os.Stdout = os.Stderr
+
+	if !logutils.HaveDebugTag(logutils.DebugKeyLintersOutput) {
+		// Don't allow linters and loader to print anything
+		log.SetOutput(io.Discard)
+	}
+
+	server := lsp.NewServer(c.log.Child(logutils.DebugKeyLSP), c, c.buildInfo.Version)
+
+	return server.Run(ctx, os.Stdin, stdout)
+}
+
+// Lint implements [lsp.Linter].
+func (c *lspCommand) Lint(ctx context.Context, dirs []string, overlay map[string][]byte,
+	changed []string,
+) ([]result.Issue, []string, error) {
+	c.updateFiles(overlay, changed)
+
+	if c.cfg.Run.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.cfg.Run.Timeout)
+		defer cancel()
+	}
+
+	// The linters accumulate the issues of their runs: they can't be reused.
+	dbManager, err := c.newDBManager()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lintersToRun, err := dbManager.GetOptimizedLinters()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lintersToLoad, err := dbManager.GetLintersToLoad()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	guard := load.NewGuard()
+
+	// The packages of the working directory are loaded: the same arguments allow to reuse the loaded packages,
+	// and the reverse dependencies of the modified packages are known.
+	pkgLoader := lint.NewPackageLoader(c.log.Child(logutils.DebugKeyLoader), c.cfg, nil, c.goenv, guard)
+	pkgLoader.SetOverlay(overlay)
+	pkgLoader.SetPackagesCache(c.pkgsCache)
+
+	contextBuilder := lint.NewContextBuilder(c.cfg, pkgLoader, c.fileCache, c.pkgCache, guard)
+
+	lintCtx, err := contextBuilder.Build(ctx, c.log.Child(logutils.DebugKeyLintersContext), lintersToLoad)
+	if err != nil {
+		return nil, nil, fmt.Errorf("context loading failed: %w", err)
+	}
+
+	linted := keepLintedPackages(lintCtx, dirs)
+
+	// The line cache is not shared: the lines can change between the runs.
+	lineCache := fsutils.NewLineCache(c.fileCache)
+
+	runner, err := lint.NewRunner(c.log.Child(logutils.DebugKeyRunner), c.cfg, linted,
+		c.goenv, lineCache, c.fileCache, dbManager, lintCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issues, err := runner.Run(ctx, lintersToRun)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return issues, linted, nil
+}
+
+func (c *lspCommand) newDBManager() (*lintersdb.Manager, error) {
+	return lintersdb.NewManager(c.log.Child(logutils.DebugKeyLintersDB), c.cfg,
+		lintersdb.NewLinterBuilder(), lintersdb.NewPluginModuleBuilder(c.log), lintersdb.NewPluginGoBuilder(c.log),
+		lintersdb.NewPluginCommandBuilder(c.log), lintersdb.NewPluginVetToolBuilder(c.log))
+}
+
+// updateFiles updates the caches with the files modified on the disk, and with the content of the unsaved files.
+func (c *lspCommand) updateFiles(overlay map[string][]byte, changed []string) {
+	var modified []string
+
+	for _, path := range changed {
+		resetFile(c.fileCache, path)
+		delete(c.overlay, path)
+
+		modified = append(modified, path)
+	}
+
+	for path := range c.overlay {
+		if _, ok := overlay[path]; !ok {
+			resetFile(c.fileCache, path)
+
+			modified = append(modified, path)
+		}
+	}
+
+	for path, content := range overlay {
+		if previous, ok := c.overlay[path]; ok && bytes.Equal(previous, content) {
+			continue
+		}
+
+		c.setFile(path, content)
+
+		modified = append(modified, path)
+	}
+
+	c.overlay = overlay
+
+	// The packages of the modified files, and their reverse dependencies, are invalidated as by the daemon.
+	c.pkgsCache.SetOverlay(overlay)
+	c.pkgCache.Invalidate(c.pkgsCache.Invalidate(modified)...)
+}
+
+// setFile sets the content of a file in the caches.
+// The issues and the facts of the packages are cached by the hashes of their files.
+func (c *lspCommand) setFile(path string, content []byte) {
+	for _, key := range fileCacheKeys(path) {
+		c.fileCache.SetFileBytes(key, content)
+	}
+
+	cache.SetFileHash(path, sha256.Sum256(content))
+}
+
+// resetFile restores the content of a file from the disk.
+func resetFile(fileCache *fsutils.FileCache, path string) {
+	for _, key := range fileCacheKeys(path) {
+		fileCache.Invalidate(key)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		// The file has been removed: it is no longer part of a package.
+		return
+	}
+
+	cache.SetFileHash(path, sha256.Sum256(content))
+}
+
+// keepLintedPackages keeps the packages of the directories, and their reverse dependencies, as the packages to analyze:
+// the other packages are only the dependencies of the analyzed packages.
+// It returns the directories of the analyzed packages, and the directories without packages (e.g. a removed package).
+func keepLintedPackages(lintCtx *linter.Context, dirs []string) []string {
+	importers := map[*packages.Package][]*packages.Package{}
+
+	packages.Visit(lintCtx.OriginalPackages, nil, func(pkg *packages.Package) {
+		for _, imp := range pkg.Imports {
+			importers[imp] = append(importers[imp], pkg)
+		}
+	})
+
+	linted := map[*packages.Package]bool{}
+
+	var visit func(pkg *packages.Package)
+	visit = func(pkg *packages.Package) {
+		if linted[pkg] {
+			return
+		}
+
+		linted[pkg] = true
+
+		for _, importer := range importers[pkg] {
+			visit(importer)
+		}
+	}
+
+	for _, pkg := range lintCtx.OriginalPackages {
+		for _, file := range pkg.GoFiles {
+			if slices.Contains(dirs, filepath.Dir(file)) {
+				visit(pkg)
+				break
+			}
+		}
+	}
+
+	// The slices of the loaded packages can be kept by the packages cache: they aren't modified.
+	lintCtx.Packages = filterLintedPackages(lintCtx.Packages, linted)
+	lintCtx.OriginalPackages = filterLintedPackages(lintCtx.OriginalPackages, linted)
+
+	lintedDirs := lint.PackagesDirs(lintCtx.Packages)
+
+	for _, dir := range dirs {
+		if !slices.Contains(lintedDirs, dir) {
+			lintedDirs = append(lintedDirs, dir)
+		}
+	}
+
+	sort.Strings(lintedDirs)
+
+	return lintedDirs
+}
+
+func filterLintedPackages(pkgs []*packages.Package, linted map[*packages.Package]bool) []*packages.Package {
+	var kept []*packages.Package
+
+	for _, pkg := range pkgs {
+		if linted[pkg] {
+			kept = append(kept, pkg)
+		}
+	}
+
+	return kept
+}
+
+// fileCacheKeys returns the paths used to read a file: the processors use the paths relative to the working directory.
+func fileCacheKeys(path string) []string {
+	relPath, err := fsutils.ShortestRelPath(path, "")
+	if err != nil || relPath == path {
+		return []string{path}
+	}
+
+	return []string{path, relPath}
+}
@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+)
+
+func Test_keepLintedPackages(t *testing.T) {
+	root := t.TempDir()
+
+	dir := func(name string) string {
+		return filepath.Join(root, name)
+	}
+
+	newPackage := func(name string, imports ...*packages.Package) *packages.Package {
+		pkg := &packages.Package{
+			ID:      "example.com/" + name,
+			PkgPath: "example.com/" + name,
+			GoFiles: []string{filepath.Join(dir(name), name+".go")},
+			Imports: map[string]*packages.Package{},
+		}
+
+		for _, imp := range imports {
+			pkg.Imports[imp.PkgPath] = imp
+		}
+
+		return pkg
+	}
+
+	a := newPackage("a")
+	b := newPackage("b", a)
+	c := newPackage("c", b)
+	d := newPackage("d")
+
+	lintCtx := &linter.Context{
+		Packages:         []*packages.Package{a, b, c, d},
+		OriginalPackages: []*packages.Package{a, b, c, d},
+	}
+
+	// The directory "e" contains no package (e.g. a removed package).
+	linted := keepLintedPackages(lintCtx, []string{dir("a"), dir("e")})
+
+	assert.Equal(t, []string{dir("a"), dir("b"), dir("c"), dir("e")}, linted)
+	assert.Equal(t, []*packages.Package{a, b, c}, lintCtx.Packages)
+	assert.Equal(t, []*packages.Package{a, b, c}, lintCtx.OriginalPackages)
+}
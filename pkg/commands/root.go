@@ -13,7 +13,7 @@ import (
 	"github.com/golangci/golangci-lint/pkg/logutils"
 )
 
-func Execute(info BuildInfo) error {
+func Execute(info *BuildInfo) error {
 	return newRootCommand(info).Execute()
 }
 
@@ -31,7 +31,7 @@ type rootCommand struct {
 	log logutils.Log
 }
 
-func newRootCommand(info BuildInfo) *rootCommand {
+func newRootCommand(info *BuildInfo) *rootCommand {
 	c := &rootCommand{}
 
 	rootCmd := &cobra.Command{
@@ -60,6 +60,8 @@ func newRootCommand(info BuildInfo) *rootCommand {
 	rootCmd.AddCommand(
 		newLintersCommand(log).cmd,
 		newRunCommand(log, info).cmd,
+		newLspCommand(log, info).cmd,
+		newDaemonCommand(log, info).cmd,
 		newCacheCommand().cmd,
 		newConfigCommand(log, info).cmd,
 		newVersionCommand(info).cmd,
@@ -11,6 +11,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"runtime/debug"
 	"runtime/pprof"
 	"runtime/trace"
 	"sort"
@@ -30,9 +31,11 @@ import (
 	"github.com/golangci/golangci-lint/internal/cache"
 	"github.com/golangci/golangci-lint/internal/pkgcache"
 	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/daemon"
 	"github.com/golangci/golangci-lint/pkg/exitcodes"
 	"github.com/golangci/golangci-lint/pkg/fsutils"
 	"github.com/golangci/golangci-lint/pkg/goanalysis/load"
+	"github.com/golangci/golangci-lint/pkg/goanalysis/profile"
 	"github.com/golangci/golangci-lint/pkg/goutil"
 	"github.com/golangci/golangci-lint/pkg/lint"
 	"github.com/golangci/golangci-lint/pkg/lint/linter"
@@ -66,7 +69,11 @@ type runOptions struct {
 	MemProfilePath string // Flag only.
 	TracePath      string // Flag only.
 
+	ProfileLintersPath string // Flag only.
+
 	PrintResourcesUsage bool // Flag only.
+
+	UseDaemon bool // Flag only.
 }
 
 type runCommand struct {
@@ -77,6 +84,9 @@ type runCommand struct {
 
 	cfg *config.Config
 
+	// loader loads the configurations of the modules of a run with several modules.
+	loader *config.Loader
+
 	buildInfo BuildInfo
 
 	dbManager *lintersdb.Manager
@@ -90,6 +100,11 @@ type runCommand struct {
 	contextBuilder *lint.ContextBuilder
 	goenv          *goutil.Env
 
+	pkgCache  *pkgcache.Cache
+	loadGuard *load.Guard
+
+	profiler *profile.Profiler
+
 	fileCache *fsutils.FileCache
 	lineCache *fsutils.LineCache
 
@@ -98,7 +113,7 @@ type runCommand struct {
 	exitCode int
 }
 
-func newRunCommand(logger logutils.Log, info BuildInfo) *runCommand {
+func newRunCommand(logger logutils.Log, info *BuildInfo) *runCommand {
 	reportData := &report.Data{}
 
 	c := &runCommand{
@@ -107,7 +122,7 @@ func newRunCommand(logger logutils.Log, info BuildInfo) *runCommand {
 		debugf:     logutils.Debug(logutils.DebugKeyExec),
 		cfg:        config.NewDefault(),
 		reportData: reportData,
-		buildInfo:  info,
+		buildInfo:  *info,
 	}
 
 	runCmd := &cobra.Command{
@@ -140,6 +155,9 @@ func newRunCommand(logger logutils.Log, info BuildInfo) *runCommand {
 	setupOutputFlagSet(c.viper, fs)
 	setupIssuesFlagSet(c.viper, fs)
 
+	fs.BoolVar(&c.opts.UseDaemon, "daemon", false,
+		color.GreenString("Send the analysis to the daemon of the working directory (see `golangci-lint daemon`), if it's running"))
+
 	setupRunPersistentFlags(runCmd.PersistentFlags(), &c.opts)
 
 	c.cmd = runCmd
@@ -161,6 +179,8 @@ func (c *runCommand) persistentPreRunE(cmd *cobra.Command, args []string) error
 		return fmt.Errorf("can't load config: %w", err)
 	}
 
+	c.loader = loader
+
 	if c.cfg.Run.Concurrency == 0 {
 		backup := runtime.GOMAXPROCS(0)
 
@@ -173,9 +193,27 @@ func (c *runCommand) persistentPreRunE(cmd *cobra.Command, args []string) error
 		runtime.GOMAXPROCS(c.cfg.Run.Concurrency)
 	}
 
+	setMemoryLimit(c.cfg)
+
 	return nil
 }
 
+// setMemoryLimit sets the soft memory limit of the runtime: the analysis is throttled near the limit.
+// The limit is set by GOMEMLIMIT, or by the configuration.
+func setMemoryLimit(cfg *config.Config) {
+	if cfg.Run.MemoryLimit == "" {
+		return
+	}
+
+	// The configuration is already validated.
+	limit, err := config.ParseMemoryLimit(cfg.Run.MemoryLimit)
+	if err != nil {
+		return
+	}
+
+	debug.SetMemoryLimit(limit)
+}
+
 func (c *runCommand) persistentPostRunE(_ *cobra.Command, _ []string) error {
 	if err := c.stopTracing(); err != nil {
 		return err
@@ -188,14 +226,15 @@ func (c *runCommand) persistentPostRunE(_ *cobra.Command, _ []string) error {
 
 func (c *runCommand) preRunE(_ *cobra.Command, args []string) error {
 	dbManager, err := lintersdb.NewManager(c.log.Child(logutils.DebugKeyLintersDB), c.cfg,
-		lintersdb.NewLinterBuilder(), lintersdb.NewPluginModuleBuilder(c.log), lintersdb.NewPluginGoBuilder(c.log))
+		lintersdb.NewLinterBuilder(), lintersdb.NewPluginModuleBuilder(c.log), lintersdb.NewPluginGoBuilder(c.log),
+		lintersdb.NewPluginCommandBuilder(c.log), lintersdb.NewPluginVetToolBuilder(c.log))
 	if err != nil {
 		return err
 	}
 
 	c.dbManager = dbManager
 
-	printer, err := printers.NewPrinter(c.log, &c.cfg.Output, c.reportData)
+	printer, err := printers.NewPrinter(c.log, &c.cfg.Output, c.reportData, dbManager.GetAllSupportedLinterConfigs())
 	if err != nil {
 		return err
 	}
@@ -209,16 +248,21 @@ func (c *runCommand) preRunE(_ *cobra.Command, args []string) error {
 
 	sw := timeutils.NewStopwatch("pkgcache", c.log.Child(logutils.DebugKeyStopwatch))
 
-	pkgCache, err := pkgcache.NewCache(sw, c.log.Child(logutils.DebugKeyPkgCache))
+	c.pkgCache, err = pkgcache.NewCache(sw, c.log.Child(logutils.DebugKeyPkgCache))
 	if err != nil {
 		return fmt.Errorf("failed to build packages cache: %w", err)
 	}
 
-	guard := load.NewGuard()
+	c.loadGuard = load.NewGuard()
 
-	pkgLoader := lint.NewPackageLoader(c.log.Child(logutils.DebugKeyLoader), c.cfg, args, c.goenv, guard)
+	pkgLoader := lint.NewPackageLoader(c.log.Child(logutils.DebugKeyLoader), c.cfg, args, c.goenv, c.loadGuard)
 
-	c.contextBuilder = lint.NewContextBuilder(c.cfg, pkgLoader, c.fileCache, pkgCache, guard)
+	c.contextBuilder = lint.NewContextBuilder(c.cfg, pkgLoader, c.fileCache, c.pkgCache, c.loadGuard)
+
+	if c.opts.ProfileLintersPath != "" {
+		c.profiler = profile.NewProfiler()
+		c.contextBuilder.SetProfiler(c.profiler)
+	}
 
 	if err = initHashSalt(c.buildInfo.Version, c.cfg); err != nil {
 		return fmt.Errorf("failed to init hash salt: %w", err)
@@ -351,6 +395,23 @@ func (c *runCommand) runAndPrint(ctx context.Context, args []string) error {
 		return err // XXX: don't lose type
 	}
 
+	if ctx.Err() != nil {
+		// The timeout is reported by setupExitCode.
+		if !c.cfg.Run.TimeoutPartialResults {
+			return nil
+		}
+
+		c.reportData.Incomplete = true
+		c.log.Warnf("Timeout exceeded: only the issues of the packages analyzed before the timeout are printed")
+	}
+
+	if c.profiler != nil {
+		err = c.writeLintersProfile()
+		if err != nil {
+			return err
+		}
+	}
+
 	// Fills linters information for the JSON printer.
 	for _, lc := range c.dbManager.GetAllSupportedLinterConfigs() {
 		isEnabled := enabledLintersMap[lc.Name()] != nil
@@ -373,12 +434,41 @@ func (c *runCommand) runAndPrint(ctx context.Context, args []string) error {
 
 // runAnalysis executes the linters that have been enabled in the configuration.
 func (c *runCommand) runAnalysis(ctx context.Context, args []string) ([]result.Issue, error) {
+	modules, err := lint.FindModules(c.cfg, c.goenv, args)
+	if err != nil {
+		return nil, fmt.Errorf("can't find the modules: %w", err)
+	}
+
+	if len(modules) > 0 {
+		return c.runModulesAnalysis(ctx, args, modules)
+	}
+
+	// The fixes are applied, and the analyzers are profiled, locally.
+	if c.opts.UseDaemon && !c.cfg.Issues.NeedFix && c.profiler == nil {
+		issues, errD := c.runAnalysisWithDaemon(ctx, args)
+		if !errors.Is(errD, daemon.ErrUnavailable) {
+			return issues, errD
+		}
+
+		c.log.Warnf("Running without the daemon: %v", errD)
+	}
+
 	lintersToRun, err := c.dbManager.GetOptimizedLinters()
 	if err != nil {
 		return nil, err
 	}
 
-	lintCtx, err := c.contextBuilder.Build(ctx, c.log.Child(logutils.DebugKeyLintersContext), lintersToRun)
+	// The packages are loaded for the linters of the overrides of the configuration too.
+	lintersToLoad, err := c.dbManager.GetLintersToLoad()
+	if err != nil {
+		return nil, err
+	}
+
+	lintCtx, err := c.contextBuilder.Build(ctx, c.log.Child(logutils.DebugKeyLintersContext), lintersToLoad)
+	if errors.Is(err, lint.ErrNoChangedPackages) {
+		return nil, nil
+	}
+
 	if err != nil {
 		return nil, fmt.Errorf("context loading failed: %w", err)
 	}
@@ -389,9 +479,203 @@ func (c *runCommand) runAnalysis(ctx context.Context, args []string) ([]result.I
 		return nil, err
 	}
 
+	// The fixer needs all the issues: the issues are printed after the fixes.
+	if !c.cfg.Issues.NeedFix {
+		streamed, errS := c.printer.StartStream()
+		if errS != nil {
+			return nil, errS
+		}
+
+		if streamed {
+			runner.SetStream(c.printer)
+		}
+	}
+
 	return runner.Run(ctx, lintersToRun)
 }
 
+// runModulesAnalysis executes the linters on the packages of several modules (e.g. the modules of a workspace):
+// the packages of each module are loaded from the directory of the module, with the configuration of the module.
+// The issues of the modules are merged into one report.
+func (c *runCommand) runModulesAnalysis(ctx context.Context, args []string, modules []lint.Module) ([]result.Issue, error) {
+	c.log.Infof("Analyzing %d modules", len(modules))
+
+	var issues []result.Issue
+
+	// The directories of the packages of all the modules.
+	analyzedDirs := []string{}
+
+	for _, mod := range modules {
+		// Don't start the analysis of the next modules after the cancellation.
+		if ctx.Err() != nil {
+			break
+		}
+
+		moduleIssues, moduleDirs, err := c.runModuleAnalysis(ctx, args, mod)
+		if err != nil {
+			return nil, fmt.Errorf("module %s: %w", mod.Path, err)
+		}
+
+		issues = append(issues, moduleIssues...)
+		analyzedDirs = append(analyzedDirs, moduleDirs...)
+	}
+
+	// The cache is salted with the configuration of the run again.
+	if err := initHashSalt(c.buildInfo.Version, c.cfg); err != nil {
+		return nil, fmt.Errorf("failed to init hash salt: %w", err)
+	}
+
+	runner, err := lint.NewReportRunner(c.log.Child(logutils.DebugKeyRunner), c.cfg,
+		c.lineCache, c.fileCache, c.dbManager, analyzedDirs)
+	if err != nil {
+		return nil, err
+	}
+
+	return runner.Process(issues), nil
+}
+
+// runModuleAnalysis returns the issues of the module, and the directories of its analyzed packages.
+func (c *runCommand) runModuleAnalysis(ctx context.Context, args []string, mod lint.Module) ([]result.Issue, []string, error) {
+	c.log.Infof("Analyzing the module %s (%s)", mod.Path, strings.Join(mod.Args, " "))
+
+	cfg, err := c.loader.LoadModule(mod.Dir, config.LoadOptions{Validation: true})
+	if err != nil {
+		return nil, nil, fmt.Errorf("can't load config: %w", err)
+	}
+
+	// The cached issues and facts depend on the settings of the module.
+	if err = initHashSalt(c.buildInfo.Version, cfg); err != nil {
+		return nil, nil, fmt.Errorf("failed to init hash salt: %w", err)
+	}
+
+	dbManager, err := lintersdb.NewManager(c.log.Child(logutils.DebugKeyLintersDB), cfg,
+		lintersdb.NewLinterBuilder(), lintersdb.NewPluginModuleBuilder(c.log), lintersdb.NewPluginGoBuilder(c.log),
+		lintersdb.NewPluginCommandBuilder(c.log), lintersdb.NewPluginVetToolBuilder(c.log))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lintersToRun, err := dbManager.GetOptimizedLinters()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lintersToLoad, err := dbManager.GetLintersToLoad()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pkgLoader := lint.NewPackageLoader(c.log.Child(logutils.DebugKeyLoader), cfg, mod.Args, c.goenv, c.loadGuard)
+	pkgLoader.SetDir(mod.Dir)
+
+	contextBuilder := lint.NewContextBuilder(cfg, pkgLoader, c.fileCache, c.pkgCache, c.loadGuard)
+	contextBuilder.SetProfiler(c.profiler)
+
+	lintCtx, err := contextBuilder.Build(ctx, c.log.Child(logutils.DebugKeyLintersContext), lintersToLoad)
+	if errors.Is(err, lint.ErrNoChangedPackages) {
+		return nil, nil, nil
+	}
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("context loading failed: %w", err)
+	}
+
+	runner, err := lint.NewModuleRunner(c.log.Child(logutils.DebugKeyRunner), cfg, args,
+		c.goenv, c.lineCache, c.fileCache, dbManager, lintCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issues, err := runner.Run(ctx, lintersToRun)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wd, err := fsutils.Getwd()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	moduleDir, err := filepath.Rel(wd, mod.Dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for i := range issues {
+		// The paths of the issues are relative to the working directory.
+		filename, err := filepath.Rel(moduleDir, issues[i].FilePath())
+		if err != nil {
+			filename = issues[i].FilePath()
+		}
+
+		issues[i].Module = &result.Module{Path: mod.Path, Dir: moduleDir, Filename: filename}
+	}
+
+	return issues, lint.PackagesDirs(lintCtx.Packages), nil
+}
+
+// runAnalysisWithDaemon sends the analysis to the daemon of the working directory.
+// The lock is held during the analysis: the daemon doesn't lock the cache.
+func (c *runCommand) runAnalysisWithDaemon(ctx context.Context, args []string) ([]result.Issue, error) {
+	wd, err := fsutils.Getwd()
+	if err != nil {
+		return nil, err
+	}
+
+	req := &daemon.Request{
+		Dir:   wd,
+		Args:  args,
+		Flags: map[string][]string{},
+	}
+
+	c.cmd.Flags().Visit(func(f *pflag.Flag) {
+		if value, ok := f.Value.(pflag.SliceValue); ok {
+			req.Flags[f.Name] = value.GetSlice()
+		} else {
+			req.Flags[f.Name] = []string{f.Value.String()}
+		}
+	})
+
+	socketPath, err := daemon.SocketPath(wd)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", daemon.ErrUnavailable, err)
+	}
+
+	issues, resp, err := daemon.Lint(ctx, socketPath, req)
+	if resp != nil {
+		for _, warning := range resp.Report.Warnings {
+			logger := c.log
+			if warning.Tag != "" {
+				logger = logger.Child(warning.Tag)
+			}
+
+			logger.Warnf("%s", warning.Text)
+		}
+
+		if resp.Report.Error != "" {
+			c.reportData.Error = resp.Report.Error
+		}
+	}
+
+	return issues, err
+}
+
+// writeLintersProfile writes the report of the profiler, and prints the slowest analyzers.
+func (c *runCommand) writeLintersProfile() error {
+	const slowestCount = 20
+
+	profileReport := c.profiler.Report()
+
+	err := profileReport.WriteFile(c.opts.ProfileLintersPath)
+	if err != nil {
+		return fmt.Errorf("can't write the profile of the linters: %w", err)
+	}
+
+	c.cmd.PrintErrf("Slowest analyzers (the full report is in %s):\n", c.opts.ProfileLintersPath)
+
+	return profileReport.PrintSlowest(c.cmd.ErrOrStderr(), slowestCount)
+}
+
 func (c *runCommand) setOutputToDevNull() (savedStdout, savedStderr *os.File) {
 	savedStdout, savedStderr = os.Stdout, os.Stderr
 	devNull, err := os.Open(os.DevNull)
@@ -585,6 +869,8 @@ func setupRunPersistentFlags(fs *pflag.FlagSet, opts *runOptions) {
 	fs.StringVar(&opts.CPUProfilePath, "cpu-profile-path", "", color.GreenString("Path to CPU profile output file"))
 	fs.StringVar(&opts.MemProfilePath, "mem-profile-path", "", color.GreenString("Path to memory profile output file"))
 	fs.StringVar(&opts.TracePath, "trace-path", "", color.GreenString("Path to trace output file"))
+	fs.StringVar(&opts.ProfileLintersPath, "profile-linters", "",
+		color.GreenString("Path to the JSON report of the time and memory spent by each analyzer on each package"))
 }
 
 func getDefaultConcurrency() int {
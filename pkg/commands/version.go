@@ -17,6 +17,17 @@ type BuildInfo struct {
 	Version   string `json:"version"`
 	Commit    string `json:"commit"`
 	Date      string `json:"date"`
+
+	// Plugins embedded by `golangci-lint custom`.
+	Plugins []PluginInfo `json:"plugins,omitempty"`
+}
+
+// PluginInfo is the information about a plugin embedded inside a custom binary.
+type PluginInfo struct {
+	Module  string `json:"module"`
+	Version string `json:"version,omitempty"`
+	Sum     string `json:"sum,omitempty"`
+	Path    string `json:"path,omitempty"`
 }
 
 func (b BuildInfo) String() string {
@@ -41,8 +52,8 @@ type versionCommand struct {
 	info BuildInfo
 }
 
-func newVersionCommand(info BuildInfo) *versionCommand {
-	c := &versionCommand{info: info}
+func newVersionCommand(info *BuildInfo) *versionCommand {
+	c := &versionCommand{info: *info}
 
 	versionCmd := &cobra.Command{
 		Use:               "version",
@@ -79,7 +90,8 @@ func (c *versionCommand) execute(_ *cobra.Command, _ []string) error {
 
 		default:
 			fmt.Println(info.String())
-			return printVersion(os.Stdout, c.info)
+			printPlugins(os.Stdout, c.info.Plugins)
+			return printVersion(os.Stdout, &c.info)
 		}
 	}
 
@@ -92,11 +104,23 @@ func (c *versionCommand) execute(_ *cobra.Command, _ []string) error {
 		return json.NewEncoder(os.Stdout).Encode(c.info)
 
 	default:
-		return printVersion(os.Stdout, c.info)
+		return printVersion(os.Stdout, &c.info)
 	}
 }
 
-func printVersion(w io.Writer, info BuildInfo) error {
+func printVersion(w io.Writer, info *BuildInfo) error {
 	_, err := fmt.Fprintln(w, info.String())
 	return err
 }
+
+// printPlugins prints the plugins with the same layout as the dependencies of [debug.BuildInfo].
+func printPlugins(w io.Writer, plugins []PluginInfo) {
+	for _, plugin := range plugins {
+		if plugin.Path != "" {
+			_, _ = fmt.Fprintf(w, "plugin\t%s\t=> %s\n", plugin.Module, plugin.Path)
+			continue
+		}
+
+		_, _ = fmt.Fprintf(w, "plugin\t%s\t%s\t%s\n", plugin.Module, plugin.Version, plugin.Sum)
+	}
+}
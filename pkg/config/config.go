@@ -1,11 +1,14 @@
 package config
 
 import (
+	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 
 	hcversion "github.com/hashicorp/go-version"
 	"github.com/ldez/gomoddirectives"
+	"golang.org/x/mod/modfile"
 )
 
 // Config encapsulates the config data specified in the golangci-lint YAML config file.
@@ -21,6 +24,8 @@ type Config struct {
 	Issues          Issues          `mapstructure:"issues"`
 	Severity        Severity        `mapstructure:"severity"`
 
+	Overrides []Override `mapstructure:"overrides"`
+
 	InternalCmdTest bool // Option is used only for testing golangci-lint command, don't use it
 	InternalTest    bool // Option is used only for testing golangci-lint code, don't use it
 }
@@ -46,6 +51,12 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	for i := range c.Overrides {
+		if err := c.Overrides[i].Validate(); err != nil {
+			return fmt.Errorf("error in override #%d: %w", i, err)
+		}
+	}
+
 	return nil
 }
 
@@ -74,17 +85,40 @@ func IsGoGreaterThanOrEqual(current, limit string) bool {
 	return v1.GreaterThanOrEqual(l)
 }
 
-func detectGoVersion() string {
-	file, _ := gomoddirectives.GetModuleFile()
+// detectGoVersion returns the Go version, and its source.
+// The version is read from the go.mod of the module directory, or of the current module if the directory is empty.
+func detectGoVersion(moduleDir string) (string, Source) {
+	file, name := readModuleFile(moduleDir)
 
 	if file != nil && file.Go != nil && file.Go.Version != "" {
-		return file.Go.Version
+		return file.Go.Version, Source{Kind: SourceFile, Names: []string{name}}
 	}
 
 	v := os.Getenv("GOVERSION")
 	if v != "" {
-		return v
+		return v, Source{Kind: SourceEnv, Names: []string{"GOVERSION"}}
+	}
+
+	return "1.17", Source{Kind: SourceDefault}
+}
+
+func readModuleFile(moduleDir string) (file *modfile.File, name string) {
+	if moduleDir == "" {
+		file, _ = gomoddirectives.GetModuleFile()
+		return file, "go.mod"
+	}
+
+	path := filepath.Join(moduleDir, "go.mod")
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, path
+	}
+
+	file, err = modfile.ParseLax(path, data, nil)
+	if err != nil {
+		return nil, path
 	}
 
-	return "1.17"
+	return file, path
 }
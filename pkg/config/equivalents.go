@@ -0,0 +1,62 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+const equivalentMinSourcesCount = 2
+
+// DefaultEquivalents are the groups of issues reported by several linters for the same problem.
+// A source is a linter, or a rule of a linter (`linter/rule`).
+// The first source of a group reporting an issue on a line is the canonical issue.
+var DefaultEquivalents = [][]string{
+	// Unchecked errors.
+	{"errcheck", "gosec/G104", "revive/unhandled-error"},
+	// Ineffectual assignments.
+	{"ineffassign", "staticcheck/SA4006"},
+	// Shadowing of predeclared identifiers.
+	{"predeclared", "gocritic/builtinShadow", "revive/redefines-builtin-id"},
+	// Shadowing of imported packages.
+	{"revive/import-shadowing", "gocritic/importShadow"},
+	// Packages imported several times.
+	{"stylecheck/ST1019", "gocritic/dupImport", "revive/duplicated-imports"},
+	// Capitalized or punctuated error strings.
+	{"stylecheck/ST1005", "revive/error-strings"},
+	// Names not following the Go conventions.
+	{"stylecheck/ST1003", "revive/var-naming"},
+	// Yoda conditions.
+	{"stylecheck/ST1017", "gocritic/yodaStyleExpr"},
+	// Empty branches.
+	{"revive/empty-block", "staticcheck/SA9003"},
+}
+
+// SplitEquivalentSource splits a source of an equivalent into the name of the linter, and the optional rule.
+func SplitEquivalentSource(source string) (linter, rule string) {
+	linter, rule, _ = strings.Cut(source, "/")
+	return linter, rule
+}
+
+func validateEquivalent(sources []string) error {
+	if len(sources) < equivalentMinSourcesCount {
+		return fmt.Errorf("at least %d sources should be set", equivalentMinSourcesCount)
+	}
+
+	seen := map[string]bool{}
+
+	for _, source := range sources {
+		linter, rule := SplitEquivalentSource(source)
+
+		if linter == "" || strings.Contains(rule, "/") {
+			return fmt.Errorf("invalid source %q: should be `linter` or `linter/rule`", source)
+		}
+
+		if seen[source] {
+			return fmt.Errorf("duplicated source %q", source)
+		}
+
+		seen[source] = true
+	}
+
+	return nil
+}
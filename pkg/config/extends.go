@@ -0,0 +1,414 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/spf13/viper"
+)
+
+// extendsKey is the key of the configuration files extended by a configuration file.
+const extendsKey = "extends"
+
+// appendedLists are the lists merged with the lists of the extended configuration files.
+// The items of the extending file come first: the first matching rule wins (ex: severity.rules).
+// The other lists, and the other values, of the extending file replace the values of the extended files.
+var appendedLists = []string{
+	"linters.enable",
+	"linters.disable",
+	"linters.presets",
+	"issues.exclude",
+	"issues.exclude-rules",
+	"issues.include",
+	"issues.exclude-dirs",
+	"issues.exclude-files",
+	"severity.rules",
+}
+
+// settingsFile contains the settings of a configuration file, merged with the settings of the files it extends.
+type settingsFile struct {
+	settings map[string]any
+
+	// sources contains the files setting each value (key -> paths).
+	sources map[string][]string
+}
+
+// loadSettingsFile reads a configuration file and the files it extends.
+// The chain contains the extending files: it's used to detect the cycles.
+func (l *Loader) loadSettingsFile(path string, chain []string) (*settingsFile, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if slices.Contains(chain, path) {
+		return nil, fmt.Errorf("cycle of extended configuration files: %s", strings.Join(append(chain, path), " -> "))
+	}
+
+	v := viper.New()
+	v.SetConfigFile(path)
+
+	// Assume YAML if the file has no extension.
+	if filepath.Ext(path) == "" {
+		v.SetConfigType("yaml")
+	}
+
+	err = v.ReadInConfig()
+	if err != nil {
+		return nil, fmt.Errorf("can't read config file %s: %w", path, err)
+	}
+
+	extends, err := getExtends(v.Get(extendsKey))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	settings := v.AllSettings()
+	delete(settings, extendsKey)
+
+	file := &settingsFile{settings: map[string]any{}, sources: map[string][]string{}}
+
+	for _, extended := range extends {
+		extendedPath, err := resolveExtendedPath(filepath.Dir(path), extended)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		base, err := l.loadSettingsFile(extendedPath, append(chain, path))
+		if err != nil {
+			return nil, err
+		}
+
+		// The last extended files have the precedence.
+		file = mergeSettingsFiles(file, base)
+	}
+
+	current := &settingsFile{settings: settings, sources: map[string][]string{}}
+	setSources(current.sources, "", settings, path)
+
+	return mergeSettingsFiles(file, current), nil
+}
+
+// resolveExtendedPath returns the path of an extended configuration file.
+// The local paths are relative to the directory of the extending file.
+// The other paths start with the path of a module required by the module of the extending file
+// (ex: github.com/org/lint/base.yml).
+func resolveExtendedPath(dir, path string) (string, error) {
+	expanded, err := homedir.Expand(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to expand extended configuration path %q: %w", path, err)
+	}
+
+	if filepath.IsAbs(expanded) {
+		return expanded, nil
+	}
+
+	local := filepath.Join(dir, expanded)
+
+	if _, err = os.Stat(local); err == nil || strings.HasPrefix(path, ".") {
+		return local, nil
+	}
+
+	resolved, err := resolveModulePath(dir, path)
+	if err != nil {
+		return "", fmt.Errorf("%s %q: %w", extendsKey, path, err)
+	}
+
+	return resolved, nil
+}
+
+// resolveModulePath returns the path of a file of a module required by the module of the directory.
+// Only the modules that can contain the file are listed.
+// The module is not downloaded: it must be in the module cache, or replaced by a local directory.
+func resolveModulePath(dir, path string) (string, error) {
+	candidates := modulePaths(path)
+	if len(candidates) == 0 {
+		return "", errors.New("no such file")
+	}
+
+	modules, err := listModules(dir, candidates)
+	if err != nil {
+		return "", err
+	}
+
+	var found *module
+
+	for i := range modules {
+		mod := &modules[i]
+
+		// The candidates that are not required modules are reported as errors.
+		if mod.Error != nil {
+			continue
+		}
+
+		if found == nil || len(mod.Path) > len(found.Path) {
+			found = mod
+		}
+	}
+
+	if found == nil {
+		return "", errors.New("no such file, and no required module contains it")
+	}
+
+	modDir := found.Dir
+	if found.Replace != nil {
+		modDir = found.Replace.Dir
+	}
+
+	if modDir == "" {
+		return "", fmt.Errorf("the module %s is not in the module cache (see `go mod download`)", found.Path)
+	}
+
+	return filepath.Join(modDir, filepath.FromSlash(strings.TrimPrefix(path, found.Path))), nil
+}
+
+// modulePaths returns the paths of the modules that can contain a file: the parent paths of the file path.
+func modulePaths(path string) []string {
+	var paths []string
+
+	for i := strings.LastIndex(path, "/"); i > 0; i = strings.LastIndex(path[:i], "/") {
+		paths = append(paths, path[:i])
+	}
+
+	return paths
+}
+
+type module struct {
+	Path    string
+	Dir     string
+	Replace *module
+	Error   *struct{ Err string }
+}
+
+// listModules returns the modules of the paths, as seen by the module of the directory.
+// The paths that are not required modules have an error.
+func listModules(dir string, paths []string) ([]module, error) {
+	//nolint:gosec // The paths are the module paths of an extends entry of the configuration.
+	cmd := exec.Command("go", append([]string{"list", "-mod=readonly", "-m", "-e", "-json"}, paths...)...)
+	cmd.Dir = dir
+
+	stderr := new(bytes.Buffer)
+	cmd.Stderr = stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", strings.Join(cmd.Args, " "), err, strings.TrimSpace(stderr.String()))
+	}
+
+	var modules []module
+
+	decoder := json.NewDecoder(bytes.NewReader(out))
+
+	for {
+		var mod module
+
+		err = decoder.Decode(&mod)
+		if errors.Is(err, io.EOF) {
+			return modules, nil
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("can't decode the list of modules: %w", err)
+		}
+
+		modules = append(modules, mod)
+	}
+}
+
+// getExtends returns the value of the extends key: a path, or a list of paths.
+func getExtends(value any) ([]string, error) {
+	switch value := value.(type) {
+	case nil:
+		return nil, nil
+
+	case string:
+		return []string{value}, nil
+
+	case []any:
+		var extends []string
+
+		for _, item := range value {
+			path, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid %s: %v is not a path", extendsKey, item)
+			}
+
+			extends = append(extends, path)
+		}
+
+		return extends, nil
+
+	default:
+		return nil, fmt.Errorf("invalid %s: %v is not a path or a list of paths", extendsKey, value)
+	}
+}
+
+// mergeSettingsFiles merges the settings of 2 configuration files: the override has the precedence.
+func mergeSettingsFiles(base, override *settingsFile) *settingsFile {
+	merged := &settingsFile{
+		settings: mergeSettings("", base.settings, override.settings),
+		sources:  map[string][]string{},
+	}
+
+	for key, paths := range base.sources {
+		merged.sources[key] = paths
+	}
+
+	for key, paths := range override.sources {
+		if slices.Contains(appendedLists, key) {
+			merged.sources[key] = append(slices.Clone(paths), base.sources[key]...)
+			continue
+		}
+
+		// The values of the base inside a replaced value are dropped.
+		for baseKey := range merged.sources {
+			if strings.HasPrefix(baseKey, key+".") {
+				delete(merged.sources, baseKey)
+			}
+		}
+
+		merged.sources[key] = paths
+	}
+
+	return merged
+}
+
+func mergeSettings(prefix string, base, override map[string]any) map[string]any {
+	merged := make(map[string]any, len(base)+len(override))
+
+	for key, value := range base {
+		merged[key] = value
+	}
+
+	for key, value := range override {
+		path := joinKey(prefix, key)
+
+		baseValue, ok := merged[key]
+		if !ok {
+			merged[key] = value
+			continue
+		}
+
+		baseMap, baseIsMap := baseValue.(map[string]any)
+		overrideMap, overrideIsMap := value.(map[string]any)
+
+		switch {
+		case baseIsMap && overrideIsMap:
+			merged[key] = mergeSettings(path, baseMap, overrideMap)
+
+		case slices.Contains(appendedLists, path):
+			merged[key] = appendList(value, baseValue)
+
+		default:
+			merged[key] = value
+		}
+	}
+
+	if prefix == "linters" {
+		resolveLinters(merged, override)
+	}
+
+	return merged
+}
+
+// resolveLinters removes the linters of the base enabled (or disabled) by the override from the disabled (or enabled) linters.
+func resolveLinters(merged, override map[string]any) {
+	enabled := toSlice(override["enable"])
+	disabled := toSlice(override["disable"])
+
+	if _, ok := merged["enable"]; ok {
+		merged["enable"] = removeItems(toSlice(merged["enable"]), disabled, enabled)
+	}
+
+	if _, ok := merged["disable"]; ok {
+		merged["disable"] = removeItems(toSlice(merged["disable"]), enabled, disabled)
+	}
+
+	// enable-all and disable-all are exclusive.
+	if isTrue(override["enable-all"]) {
+		delete(merged, "disable-all")
+	}
+
+	if isTrue(override["disable-all"]) {
+		delete(merged, "enable-all")
+	}
+}
+
+// removeItems removes the items from the list, except the kept items.
+func removeItems(list, items, kept []any) []any {
+	return slices.DeleteFunc(list, func(item any) bool {
+		return slices.Contains(items, item) && !slices.Contains(kept, item)
+	})
+}
+
+// appendList appends the items of the base list to the items of the override list, without duplicates.
+func appendList(override, base any) []any {
+	var merged []any
+
+	for _, item := range append(toSlice(override), toSlice(base)...) {
+		if !slices.ContainsFunc(merged, func(existing any) bool { return reflect.DeepEqual(existing, item) }) {
+			merged = append(merged, item)
+		}
+	}
+
+	return merged
+}
+
+func toSlice(value any) []any {
+	switch value := value.(type) {
+	case nil:
+		return nil
+
+	case []any:
+		return slices.Clone(value)
+
+	case string:
+		// The lists of strings can be written as comma-separated values.
+		var items []any
+		for _, item := range strings.Split(value, ",") {
+			items = append(items, strings.TrimSpace(item))
+		}
+
+		return items
+
+	default:
+		return []any{value}
+	}
+}
+
+func isTrue(value any) bool {
+	b, ok := value.(bool)
+	return ok && b
+}
+
+// setSources sets the file of the values (leaves) of the settings.
+func setSources(sources map[string][]string, prefix string, settings map[string]any, path string) {
+	for key, value := range settings {
+		key = joinKey(prefix, key)
+
+		if m, ok := value.(map[string]any); ok {
+			setSources(sources, key, m, path)
+			continue
+		}
+
+		sources[key] = []string{path}
+	}
+}
+
+func joinKey(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+
+	return prefix + "." + key
+}
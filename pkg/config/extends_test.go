@@ -0,0 +1,244 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+func TestLoader_extends(t *testing.T) {
+	dir := t.TempDir()
+
+	writeConfigFile(t, dir, "base.yml", `
+run:
+  timeout: 5m
+  tests: false
+linters:
+  fast: true
+  enable:
+    - errcheck
+    - govet
+  disable:
+    - unused
+issues:
+  exclude-rules:
+    - path: _test\.go
+      linters:
+        - errcheck
+severity:
+  default-severity: error
+  rules:
+    - severity: info
+      linters:
+        - govet
+`)
+
+	writeConfigFile(t, dir, "other.toml", `
+[run]
+timeout = "3m"
+build-tags = ["a"]
+`)
+
+	path := writeConfigFile(t, dir, ".golangci.yml", `
+extends:
+  - ./base.yml
+  - other.toml
+run:
+  build-tags:
+    - b
+linters:
+  enable:
+    - unused
+  disable:
+    - govet
+issues:
+  exclude-rules:
+    - path: internal/
+      linters:
+        - unused
+severity:
+  rules:
+    - severity: warning
+      linters:
+        - unused
+`)
+
+	cfg, loader := loadTestConfig(t, path)
+
+	assert.Equal(t, 3*time.Minute, cfg.Run.Timeout)
+	assert.False(t, cfg.Run.AnalyzeTests)
+	assert.Equal(t, []string{"b"}, cfg.Run.BuildTags)
+
+	assert.True(t, cfg.Linters.Fast)
+	assert.Equal(t, []string{"unused", "errcheck"}, cfg.Linters.Enable)
+	assert.Equal(t, []string{"govet"}, cfg.Linters.Disable)
+
+	require.Len(t, cfg.Issues.ExcludeRules, 2)
+	assert.Equal(t, "internal/", cfg.Issues.ExcludeRules[0].Path)
+	assert.Equal(t, `_test\.go`, cfg.Issues.ExcludeRules[1].Path)
+
+	assert.Equal(t, "error", cfg.Severity.Default)
+	require.Len(t, cfg.Severity.Rules, 2)
+	assert.Equal(t, "warning", cfg.Severity.Rules[0].Severity)
+	assert.Equal(t, "info", cfg.Severity.Rules[1].Severity)
+
+	sources := loader.Sources()
+
+	assert.Equal(t, []Source{fileSource(filepath.Join(dir, "other.toml"))}, sources["run.timeout"])
+	assert.Equal(t, []Source{fileSource(filepath.Join(dir, "base.yml"))}, sources["run.tests"])
+	assert.Equal(t, []Source{fileSource(path)}, sources["run.build-tags"])
+	assert.Equal(t, []Source{fileSource(path, filepath.Join(dir, "base.yml"))}, sources["linters.enable"])
+}
+
+func TestLoader_extends_module(t *testing.T) {
+	dir := t.TempDir()
+
+	writeConfigFile(t, dir, "go.mod", `module example.com/app
+
+go 1.22
+
+require example.com/lint v0.0.0
+
+replace example.com/lint => ./lint
+`)
+
+	writeConfigFile(t, dir, "lint/go.mod", "module example.com/lint\n\ngo 1.22\n")
+
+	writeConfigFile(t, dir, "lint/configs/base.yml", `
+run:
+  timeout: 5m
+`)
+
+	path := writeConfigFile(t, dir, ".golangci.yml", "extends: example.com/lint/configs/base.yml\n")
+
+	cfg, loader := loadTestConfig(t, path)
+
+	assert.Equal(t, 5*time.Minute, cfg.Run.Timeout)
+	assert.Equal(t, []Source{fileSource(filepath.Join(dir, "lint", "configs", "base.yml"))}, loader.Sources()["run.timeout"])
+}
+
+func TestLoader_extends_errors(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		files    map[string]string
+		expected string
+	}{
+		{
+			desc: "cycle",
+			files: map[string]string{
+				".golangci.yml": "extends: a.yml\n",
+				"a.yml":         "extends: b.yml\n",
+				"b.yml":         "extends: ./a.yml\n",
+			},
+			expected: "cycle of extended configuration files",
+		},
+		{
+			desc: "invalid value",
+			files: map[string]string{
+				".golangci.yml": "extends:\n  key: value\n",
+			},
+			expected: "is not a path or a list of paths",
+		},
+		{
+			desc: "missing local file",
+			files: map[string]string{
+				".golangci.yml": "extends: ./missing.yml\n",
+			},
+			expected: "missing.yml",
+		},
+		{
+			desc: "unknown module",
+			files: map[string]string{
+				"go.mod":        "module example.com/app\n\ngo 1.22\n",
+				".golangci.yml": "extends: example.com/lint/base.yml\n",
+			},
+			expected: `extends "example.com/lint/base.yml": no such file, and no required module contains it`,
+		},
+		{
+			desc: "missing file without module path",
+			files: map[string]string{
+				".golangci.yml": "extends: missing.yml\n",
+			},
+			expected: `extends "missing.yml": no such file`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+
+			for name, content := range test.files {
+				writeConfigFile(t, dir, name, content)
+			}
+
+			loader := NewLoader(logutils.NewStderrLog(logutils.DebugKeyEmpty), viper.New(),
+				pflag.NewFlagSet("test", pflag.ContinueOnError),
+				LoaderOptions{Config: filepath.Join(dir, ".golangci.yml")}, NewDefault(), nil)
+
+			err := loader.Load(LoadOptions{})
+			require.Error(t, err)
+
+			assert.Contains(t, err.Error(), test.expected)
+		})
+	}
+}
+
+func Test_mergeSettings_linters(t *testing.T) {
+	t.Parallel()
+
+	base := map[string]any{
+		"enable-all": true,
+		"disable":    []any{"gosec", "lll"},
+	}
+
+	override := map[string]any{
+		"disable-all": true,
+		"enable":      []any{"lll"},
+	}
+
+	expected := map[string]any{
+		"disable-all": true,
+		"enable":      []any{"lll"},
+		"disable":     []any{"gosec"},
+	}
+
+	assert.Equal(t, expected, mergeSettings("linters", base, override))
+}
+
+func fileSource(paths ...string) Source {
+	return Source{Kind: SourceFile, Names: paths}
+}
+
+func loadTestConfig(t *testing.T, path string) (*Config, *Loader) {
+	t.Helper()
+
+	cfg := NewDefault()
+
+	loader := NewLoader(logutils.NewStderrLog(logutils.DebugKeyEmpty), viper.New(),
+		pflag.NewFlagSet("test", pflag.ContinueOnError), LoaderOptions{Config: path}, cfg, nil)
+
+	require.NoError(t, loader.Load(LoadOptions{Validation: true}))
+
+	return cfg, loader
+}
+
+func writeConfigFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
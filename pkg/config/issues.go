@@ -110,6 +110,9 @@ type Issues struct {
 	ExcludeRules           []ExcludeRule `mapstructure:"exclude-rules"`
 	UseDefaultExcludes     bool          `mapstructure:"exclude-use-default"`
 
+	Equivalents           [][]string `mapstructure:"equivalents"`
+	UseDefaultEquivalents bool       `mapstructure:"equivalents-use-default"`
+
 	ExcludeGenerated string `mapstructure:"exclude-generated"`
 
 	ExcludeFiles []string `mapstructure:"exclude-files"`
@@ -125,18 +128,37 @@ type Issues struct {
 	WholeFiles        bool   `mapstructure:"whole-files"`
 	Diff              bool   `mapstructure:"new"`
 
-	NeedFix bool `mapstructure:"fix"`
+	Baseline      string `mapstructure:"baseline"`
+	BaselineWrite string `mapstructure:"baseline-write"`
+
+	NeedFix     bool   `mapstructure:"fix"`
+	FixDiff     bool   `mapstructure:"fix-diff"`
+	FixDiffPath string `mapstructure:"fix-diff-path"`
 
 	ExcludeGeneratedStrict bool `mapstructure:"exclude-generated-strict"` // Deprecated: use ExcludeGenerated instead.
 }
 
 func (i *Issues) Validate() error {
+	if i.FixDiff && !i.NeedFix {
+		return errors.New("fix should be 'true' to use fix-diff")
+	}
+
+	if i.FixDiffPath != "" && !i.FixDiff {
+		return errors.New("fix-diff should be 'true' to use fix-diff-path")
+	}
+
 	for i, rule := range i.ExcludeRules {
 		if err := rule.Validate(); err != nil {
 			return fmt.Errorf("error in exclude rule #%d: %w", i, err)
 		}
 	}
 
+	for i, equivalent := range i.Equivalents {
+		if err := validateEquivalent(equivalent); err != nil {
+			return fmt.Errorf("error in equivalent #%d: %w", i, err)
+		}
+	}
+
 	return nil
 }
 
@@ -150,6 +172,7 @@ func (e *ExcludeRule) Validate() error {
 
 type BaseRule struct {
 	Linters    []string
+	Rules      []string
 	Path       string
 	PathExcept string `mapstructure:"path-except"`
 	Text       string
@@ -182,6 +205,10 @@ func (b *BaseRule) Validate(minConditionsCount int) error {
 		nonBlank++
 	}
 
+	if len(b.Rules) > 0 {
+		nonBlank++
+	}
+
 	// Filtering by path counts as one condition, regardless how it is done (one or both).
 	// Otherwise, a rule with Path and PathExcept set would pass validation
 	// whereas before the introduction of path-except that wouldn't have been precise enough.
@@ -198,7 +225,7 @@ func (b *BaseRule) Validate(minConditionsCount int) error {
 	}
 
 	if nonBlank < minConditionsCount {
-		return fmt.Errorf("at least %d of (text, source, path[-except],  linters) should be set", minConditionsCount)
+		return fmt.Errorf("at least %d of (text, source, path[-except],  linters, rules) should be set", minConditionsCount)
 	}
 
 	return nil
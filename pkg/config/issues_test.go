@@ -34,7 +34,7 @@ func TestExcludeRule_Validate(t *testing.T) {
 		{
 			desc:     "empty rule",
 			rule:     &ExcludeRule{},
-			expected: "at least 2 of (text, source, path[-except],  linters) should be set",
+			expected: "at least 2 of (text, source, path[-except],  linters, rules) should be set",
 		},
 		{
 			desc: "only path rule",
@@ -43,7 +43,7 @@ func TestExcludeRule_Validate(t *testing.T) {
 					Path: "test",
 				},
 			},
-			expected: "at least 2 of (text, source, path[-except],  linters) should be set",
+			expected: "at least 2 of (text, source, path[-except],  linters, rules) should be set",
 		},
 		{
 			desc: "only path-except rule",
@@ -52,7 +52,7 @@ func TestExcludeRule_Validate(t *testing.T) {
 					PathExcept: "test",
 				},
 			},
-			expected: "at least 2 of (text, source, path[-except],  linters) should be set",
+			expected: "at least 2 of (text, source, path[-except],  linters, rules) should be set",
 		},
 		{
 			desc: "only text rule",
@@ -61,7 +61,16 @@ func TestExcludeRule_Validate(t *testing.T) {
 					Text: "test",
 				},
 			},
-			expected: "at least 2 of (text, source, path[-except],  linters) should be set",
+			expected: "at least 2 of (text, source, path[-except],  linters, rules) should be set",
+		},
+		{
+			desc: "only rules",
+			rule: &ExcludeRule{
+				BaseRule{
+					Rules: []string{"SA4006"},
+				},
+			},
+			expected: "at least 2 of (text, source, path[-except],  linters, rules) should be set",
 		},
 		{
 			desc: "only source rule",
@@ -70,7 +79,7 @@ func TestExcludeRule_Validate(t *testing.T) {
 					Source: "test",
 				},
 			},
-			expected: "at least 2 of (text, source, path[-except],  linters) should be set",
+			expected: "at least 2 of (text, source, path[-except],  linters, rules) should be set",
 		},
 		{
 			desc: "invalid path rule",
@@ -171,6 +180,24 @@ func TestExcludeRule_Validate_error(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "rule and linter",
+			rule: &ExcludeRule{
+				BaseRule{
+					Rules:   []string{"SA4006"},
+					Linters: []string{"staticcheck"},
+				},
+			},
+		},
+		{
+			desc: "path and rule",
+			rule: &ExcludeRule{
+				BaseRule{
+					Path:  "test",
+					Rules: []string{"G104"},
+				},
+			},
+		},
 		{
 			desc: "path and text",
 			rule: &ExcludeRule{
@@ -201,3 +228,106 @@ func TestExcludeRule_Validate_error(t *testing.T) {
 		})
 	}
 }
+
+func TestIssues_Validate(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		settings *Issues
+	}{
+		{
+			desc:     "empty",
+			settings: &Issues{},
+		},
+		{
+			desc: "fix-diff",
+			settings: &Issues{
+				NeedFix: true,
+				FixDiff: true,
+			},
+		},
+		{
+			desc: "fix-diff-path",
+			settings: &Issues{
+				NeedFix:     true,
+				FixDiff:     true,
+				FixDiffPath: "fixes.diff",
+			},
+		},
+		{
+			desc: "equivalents",
+			settings: &Issues{
+				Equivalents: [][]string{{"errcheck", "gosec/G104"}, {"revive/import-shadowing", "gocritic/importShadow"}},
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.settings.Validate()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestIssues_Validate_error(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		settings *Issues
+		expected string
+	}{
+		{
+			desc: "fix-diff without fix",
+			settings: &Issues{
+				FixDiff: true,
+			},
+			expected: "fix should be 'true' to use fix-diff",
+		},
+		{
+			desc: "fix-diff-path without fix-diff",
+			settings: &Issues{
+				NeedFix:     true,
+				FixDiffPath: "fixes.diff",
+			},
+			expected: "fix-diff should be 'true' to use fix-diff-path",
+		},
+		{
+			desc: "equivalent with one source",
+			settings: &Issues{
+				Equivalents: [][]string{{"errcheck"}},
+			},
+			expected: "error in equivalent #0: at least 2 sources should be set",
+		},
+		{
+			desc: "equivalent without linter",
+			settings: &Issues{
+				Equivalents: [][]string{{"errcheck", "/G104"}},
+			},
+			expected: "error in equivalent #0: invalid source \"/G104\": should be `linter` or `linter/rule`",
+		},
+		{
+			desc: "equivalent with an invalid rule",
+			settings: &Issues{
+				Equivalents: [][]string{{"errcheck", "gosec/G104/G105"}},
+			},
+			expected: "error in equivalent #0: invalid source \"gosec/G104/G105\": should be `linter` or `linter/rule`",
+		},
+		{
+			desc: "equivalent with duplicated sources",
+			settings: &Issues{
+				Equivalents: [][]string{{"errcheck", "gosec/G104", "errcheck"}},
+			},
+			expected: "error in equivalent #0: duplicated source \"errcheck\"",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.settings.Validate()
+			require.EqualError(t, err, test.expected)
+		})
+	}
+}
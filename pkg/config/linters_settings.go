@@ -4,6 +4,7 @@ import (
 	"encoding"
 	"errors"
 	"fmt"
+	"regexp"
 	"runtime"
 
 	"gopkg.in/yaml.v3"
@@ -259,6 +260,7 @@ type LintersSettings struct {
 	Reassign        ReassignSettings
 	Revive          ReviveSettings
 	RowsErrCheck    RowsErrCheckSettings
+	Rules           RulesSettings
 	SlogLint        SlogLintSettings
 	Spancheck       SpancheckSettings
 	Staticcheck     StaticCheckSettings
@@ -286,7 +288,13 @@ func (s *LintersSettings) Validate() error {
 		return err
 	}
 
-	for name, settings := range s.Custom {
+	if err := s.Rules.Validate(); err != nil {
+		return err
+	}
+
+	for name := range s.Custom {
+		settings := s.Custom[name]
+
 		if err := settings.Validate(); err != nil {
 			return fmt.Errorf("custom linter %q: %w", name, err)
 		}
@@ -817,6 +825,93 @@ type RowsErrCheckSettings struct {
 	Packages []string
 }
 
+type RulesSettings struct {
+	Rules []RuleSettings `mapstructure:"rules"`
+}
+
+func (s *RulesSettings) Validate() error {
+	names := map[string]bool{}
+
+	for i := range s.Rules {
+		rule := &s.Rules[i]
+
+		if rule.Name == "" {
+			return fmt.Errorf("rules: the name of the rule #%d is required", i+1)
+		}
+
+		if names[rule.Name] {
+			return fmt.Errorf("rules: duplicated rule %q", rule.Name)
+		}
+
+		names[rule.Name] = true
+
+		if err := rule.Validate(); err != nil {
+			return fmt.Errorf("rules: rule %q: %w", rule.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// RuleSettings is a custom rule: the code matched by the matcher of the rule is reported,
+// inside the packages and the files of the rule.
+type RuleSettings struct {
+	// Name is the ID of the rule (ex: `//nolint:rules/<name>`).
+	Name string `mapstructure:"name"`
+
+	// Message replaces the default message of the matcher.
+	Message string `mapstructure:"message"`
+
+	// Packages are the globs of the paths of the packages of the rule (all the packages by default).
+	Packages []string `mapstructure:"packages"`
+
+	// Paths are the globs of the paths of the files of the rule, relative to the working directory (all the files by default).
+	Paths []string `mapstructure:"paths"`
+
+	// The matchers: only one per rule.
+
+	// Call is a qualified function or method (ex: `time.Now`, `(*net/http.Client).Do`).
+	Call string `mapstructure:"call"`
+
+	// Type is a qualified type (ex: `net/http.Client`): any use of the type is reported.
+	Type string `mapstructure:"type"`
+
+	// Literal is a qualified type: the composite literals of the type outside of its package are reported.
+	Literal string `mapstructure:"literal"`
+
+	// Import is an import path, or a prefix of import paths (ex: `github.com/pkg/errors`, `example.com/internal/...`).
+	Import string `mapstructure:"import"`
+
+	// Identifier is a regular expression: the declared identifiers matching it are reported.
+	Identifier string `mapstructure:"identifier"`
+
+	// FirstParam is a qualified type (ex: `context.Context`):
+	// the exported functions and methods without a first parameter of this type are reported.
+	FirstParam string `mapstructure:"first-param"`
+}
+
+func (s *RuleSettings) Validate() error {
+	var matchers int
+
+	for _, matcher := range []string{s.Call, s.Type, s.Literal, s.Import, s.Identifier, s.FirstParam} {
+		if matcher != "" {
+			matchers++
+		}
+	}
+
+	if matchers != 1 {
+		return errors.New("one matcher is required: call, type, literal, import, identifier or first-param")
+	}
+
+	if s.Identifier != "" {
+		if _, err := regexp.Compile(s.Identifier); err != nil {
+			return fmt.Errorf("invalid identifier: %w", err)
+		}
+	}
+
+	return nil
+}
+
 type SlogLintSettings struct {
 	NoMixedArgs    bool     `mapstructure:"no-mixed-args"`
 	KVOnly         bool     `mapstructure:"kv-only"`
@@ -998,16 +1093,48 @@ type WSLSettings struct {
 	ForceExclusiveShortDeclarations  bool     `mapstructure:"force-short-decl-cuddling"`
 }
 
+// Scopes of the `command` custom linters.
+const (
+	CommandScopeRun     = "run"
+	CommandScopePackage = "package"
+)
+
+// Formats of the output of the `command` custom linters.
+const (
+	CommandFormatLine       = "line"
+	CommandFormatSARIF      = "sarif"
+	CommandFormatCheckstyle = "checkstyle"
+	CommandFormatJSON       = "json"
+)
+
+// DefaultCommandPattern is the pattern of the `line` format: `file:line[:col]: message`.
+const DefaultCommandPattern = `^(?P<file>[^:\s][^:]*):(?P<line>\d+)(?::(?P<col>\d+))?:\s*(?P<message>.+)$`
+
 // CustomLinterSettings encapsulates the meta-data of a private linter.
 type CustomLinterSettings struct {
 	// Type plugin type.
-	// It can be `goplugin` or `module`.
+	// It can be `goplugin`, `module`, `command` or `vettool`.
 	Type string `mapstructure:"type"`
 
 	// Path to a plugin *.so file that implements the private linter.
 	// Only for Go plugin system.
 	Path string
 
+	// Command is the executable of the linter.
+	// Only for the `command` and `vettool` types.
+	Command string `mapstructure:"command"`
+	// Args are the arguments of the command.
+	// With the `package` scope, `{dir}` and `{package}` are replaced by the directory and the path of the package.
+	// With the `vettool` type, they are the flags of the analyzers (ex: `-printf.funcs=Wrapf`).
+	Args []string `mapstructure:"args"`
+	// Scope defines how the command is run: once per run (`run`) or once per package (`package`).
+	Scope string `mapstructure:"scope"`
+	// Format of the output of the command: `line`, `sarif`, `checkstyle` or `json` (golangci-lint JSON).
+	Format string `mapstructure:"format"`
+	// Pattern is the regular expression of the `line` format.
+	// The named groups `file`, `line` and `message` are required, `col`, `rule` and `severity` are optional.
+	Pattern string `mapstructure:"pattern"`
+
 	// Description describes the purpose of the private linter.
 	Description string
 	// OriginalURL The URL containing the source code for the private linter.
@@ -1018,12 +1145,19 @@ type CustomLinterSettings struct {
 }
 
 func (s *CustomLinterSettings) Validate() error {
-	if s.Type == "module" {
+	switch s.Type {
+	case "module":
 		if s.Path != "" {
 			return errors.New("path not supported with module type")
 		}
 
 		return nil
+
+	case "command":
+		return s.validateCommand()
+
+	case "vettool":
+		return s.validateVetTool()
 	}
 
 	if s.Path == "" {
@@ -1032,3 +1166,65 @@ func (s *CustomLinterSettings) Validate() error {
 
 	return nil
 }
+
+func (s *CustomLinterSettings) validateCommand() error {
+	if s.Path != "" {
+		return errors.New("path not supported with command type")
+	}
+
+	if s.Command == "" {
+		return errors.New("command is required")
+	}
+
+	switch s.Scope {
+	case "", CommandScopeRun, CommandScopePackage:
+	default:
+		return fmt.Errorf("invalid scope %q: should be %q or %q", s.Scope, CommandScopeRun, CommandScopePackage)
+	}
+
+	switch s.Format {
+	case "", CommandFormatLine:
+	case CommandFormatSARIF, CommandFormatCheckstyle, CommandFormatJSON:
+		if s.Pattern != "" {
+			return fmt.Errorf("pattern not supported with %s format", s.Format)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("invalid format %q: should be %q, %q, %q or %q",
+			s.Format, CommandFormatLine, CommandFormatSARIF, CommandFormatCheckstyle, CommandFormatJSON)
+	}
+
+	if s.Pattern == "" {
+		return nil
+	}
+
+	re, err := regexp.Compile(s.Pattern)
+	if err != nil {
+		return fmt.Errorf("invalid pattern: %w", err)
+	}
+
+	for _, group := range []string{"file", "line", "message"} {
+		if re.SubexpIndex(group) < 0 {
+			return fmt.Errorf("invalid pattern: the group %q is required", group)
+		}
+	}
+
+	return nil
+}
+
+func (s *CustomLinterSettings) validateVetTool() error {
+	if s.Path != "" {
+		return errors.New("path not supported with vettool type")
+	}
+
+	if s.Command == "" {
+		return errors.New("command is required")
+	}
+
+	if s.Scope != "" || s.Format != "" || s.Pattern != "" {
+		return errors.New("scope, format and pattern not supported with vettool type")
+	}
+
+	return nil
+}
@@ -107,6 +107,39 @@ func TestCustomLinterSettings_Validate(t *testing.T) {
 				Type: "module",
 			},
 		},
+		{
+			desc: "type command",
+			settings: &CustomLinterSettings{
+				Type:    "command",
+				Command: "example",
+			},
+		},
+		{
+			desc: "type command with a pattern",
+			settings: &CustomLinterSettings{
+				Type:    "command",
+				Command: "example",
+				Scope:   CommandScopePackage,
+				Format:  CommandFormatLine,
+				Pattern: `^(?P<file>.+?):(?P<line>\d+): \[(?P<rule>\w+)\] (?P<message>.+)$`,
+			},
+		},
+		{
+			desc: "type vettool",
+			settings: &CustomLinterSettings{
+				Type:    "vettool",
+				Command: "example",
+				Args:    []string{"-printf.funcs=Wrapf"},
+			},
+		},
+		{
+			desc: "type command with sarif format",
+			settings: &CustomLinterSettings{
+				Type:    "command",
+				Command: "example",
+				Format:  CommandFormatSARIF,
+			},
+		},
 	}
 
 	for _, test := range testCases {
@@ -138,6 +171,93 @@ func TestCustomLinterSettings_Validate_error(t *testing.T) {
 			},
 			expected: "path not supported with module type",
 		},
+		{
+			desc: "command and path",
+			settings: &CustomLinterSettings{
+				Type:    "command",
+				Command: "example",
+				Path:    "example",
+			},
+			expected: "path not supported with command type",
+		},
+		{
+			desc: "missing command",
+			settings: &CustomLinterSettings{
+				Type: "command",
+			},
+			expected: "command is required",
+		},
+		{
+			desc: "vettool and path",
+			settings: &CustomLinterSettings{
+				Type:    "vettool",
+				Command: "example",
+				Path:    "example",
+			},
+			expected: "path not supported with vettool type",
+		},
+		{
+			desc: "vettool without command",
+			settings: &CustomLinterSettings{
+				Type: "vettool",
+			},
+			expected: "command is required",
+		},
+		{
+			desc: "vettool and format",
+			settings: &CustomLinterSettings{
+				Type:    "vettool",
+				Command: "example",
+				Format:  CommandFormatSARIF,
+			},
+			expected: "scope, format and pattern not supported with vettool type",
+		},
+		{
+			desc: "invalid scope",
+			settings: &CustomLinterSettings{
+				Type:    "command",
+				Command: "example",
+				Scope:   "file",
+			},
+			expected: `invalid scope "file": should be "run" or "package"`,
+		},
+		{
+			desc: "invalid format",
+			settings: &CustomLinterSettings{
+				Type:    "command",
+				Command: "example",
+				Format:  "xml",
+			},
+			expected: `invalid format "xml": should be "line", "sarif", "checkstyle" or "json"`,
+		},
+		{
+			desc: "pattern with sarif format",
+			settings: &CustomLinterSettings{
+				Type:    "command",
+				Command: "example",
+				Format:  CommandFormatSARIF,
+				Pattern: "(?P<file>.+)",
+			},
+			expected: "pattern not supported with sarif format",
+		},
+		{
+			desc: "invalid pattern",
+			settings: &CustomLinterSettings{
+				Type:    "command",
+				Command: "example",
+				Pattern: "(",
+			},
+			expected: "invalid pattern: error parsing regexp: missing closing ): `(`",
+		},
+		{
+			desc: "pattern without message",
+			settings: &CustomLinterSettings{
+				Type:    "command",
+				Command: "example",
+				Pattern: `(?P<file>.+):(?P<line>\d+)`,
+			},
+			expected: `invalid pattern: the group "message" is required`,
+		},
 	}
 
 	for _, test := range testCases {
@@ -228,3 +348,90 @@ func TestGovetSettings_Validate_error(t *testing.T) {
 		})
 	}
 }
+
+func TestRulesSettings_Validate(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		settings *RulesSettings
+	}{
+		{
+			desc:     "empty",
+			settings: &RulesSettings{},
+		},
+		{
+			desc: "rules",
+			settings: &RulesSettings{
+				Rules: []RuleSettings{
+					{Name: "a", Call: "time.Now", Packages: []string{"example.com/**"}},
+					{Name: "b", Identifier: "^tmp"},
+				},
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.settings.Validate()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestRulesSettings_Validate_error(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		settings *RulesSettings
+		expected string
+	}{
+		{
+			desc: "missing name",
+			settings: &RulesSettings{
+				Rules: []RuleSettings{{Call: "time.Now"}},
+			},
+			expected: "rules: the name of the rule #1 is required",
+		},
+		{
+			desc: "duplicated name",
+			settings: &RulesSettings{
+				Rules: []RuleSettings{
+					{Name: "a", Call: "time.Now"},
+					{Name: "a", Import: "unsafe"},
+				},
+			},
+			expected: `rules: duplicated rule "a"`,
+		},
+		{
+			desc: "no matcher",
+			settings: &RulesSettings{
+				Rules: []RuleSettings{{Name: "a"}},
+			},
+			expected: `rules: rule "a": one matcher is required: call, type, literal, import, identifier or first-param`,
+		},
+		{
+			desc: "several matchers",
+			settings: &RulesSettings{
+				Rules: []RuleSettings{{Name: "a", Call: "time.Now", Import: "time"}},
+			},
+			expected: `rules: rule "a": one matcher is required: call, type, literal, import, identifier or first-param`,
+		},
+		{
+			desc: "invalid identifier",
+			settings: &RulesSettings{
+				Rules: []RuleSettings{{Name: "a", Identifier: "("}},
+			},
+			expected: "rules: rule \"a\": invalid identifier: error parsing regexp: missing closing ): `(`",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.settings.Validate()
+
+			assert.EqualError(t, err, test.expected)
+		})
+	}
+}
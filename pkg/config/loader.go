@@ -40,6 +40,12 @@ type Loader struct {
 
 	cfg  *Config
 	args []string
+
+	// sources contains the sources setting each value (key -> sources).
+	sources map[string][]Source
+
+	// moduleDir is the directory of the module of the configuration (see LoadModule).
+	moduleDir string
 }
 
 func NewLoader(log logutils.Log, v *viper.Viper, fs *pflag.FlagSet, opts LoaderOptions, cfg *Config, args []string) *Loader {
@@ -50,6 +56,8 @@ func NewLoader(log logutils.Log, v *viper.Viper, fs *pflag.FlagSet, opts LoaderO
 		log:   log,
 		cfg:   cfg,
 		args:  args,
+
+		sources: map[string][]Source{},
 	}
 }
 
@@ -64,10 +72,48 @@ func (l *Loader) Load(opts LoadOptions) error {
 		return err
 	}
 
+	return l.handleConfig(opts)
+}
+
+// LoadModule loads the configuration of a module analyzed by a run with several modules.
+// The configuration file of the module directory (.golangci.yml, etc.), if any, overrides the configuration file of the run.
+// The flags keep the precedence.
+func (l *Loader) LoadModule(dir string, opts LoadOptions) (*Config, error) {
+	ml := &Loader{
+		opts:      l.opts,
+		viper:     viper.New(),
+		fs:        l.fs,
+		log:       l.log,
+		cfg:       NewDefault(),
+		args:      l.args,
+		sources:   map[string][]Source{},
+		moduleDir: dir,
+	}
+
+	ml.cfg.cfgDir = l.cfg.cfgDir
+
+	ml.bindFlags()
+
+	err := ml.parseModuleConfig(l.viper.ConfigFileUsed())
+	if err != nil {
+		return nil, err
+	}
+
+	err = ml.handleConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return ml.cfg, nil
+}
+
+func (l *Loader) handleConfig(opts LoadOptions) error {
+	l.setFlagSources()
+
 	l.applyStringSliceHack()
 
 	if opts.CheckDeprecation {
-		err = l.handleDeprecation()
+		err := l.handleDeprecation()
 		if err != nil {
 			return err
 		}
@@ -75,7 +121,7 @@ func (l *Loader) Load(opts LoadOptions) error {
 
 	l.handleGoVersion()
 
-	err = goutil.CheckGoVersion(l.cfg.Run.Go)
+	err := goutil.CheckGoVersion(l.cfg.Run.Go)
 	if err != nil {
 		return err
 	}
@@ -85,6 +131,11 @@ func (l *Loader) Load(opts LoadOptions) error {
 		return err
 	}
 
+	err = l.handleOverrides()
+	if err != nil {
+		return err
+	}
+
 	if opts.Validation {
 		err = l.cfg.Validate()
 		if err != nil {
@@ -213,6 +264,11 @@ func (l *Loader) parseConfig() error {
 		return err
 	}
 
+	err = l.handleExtends()
+	if err != nil {
+		return err
+	}
+
 	// Load configuration from all sources (flags, file).
 	if err := l.viper.Unmarshal(l.cfg, customDecoderHook()); err != nil {
 		return fmt.Errorf("can't unmarshal config by viper (flags, file): %w", err)
@@ -226,6 +282,34 @@ func (l *Loader) parseConfig() error {
 	return nil
 }
 
+// handleExtends merges the configuration file with the files it extends.
+func (l *Loader) handleExtends() error {
+	usedConfigFile := l.viper.ConfigFileUsed()
+
+	if usedConfigFile == os.Stdin.Name() {
+		if l.viper.IsSet(extendsKey) {
+			return fmt.Errorf("%s is not supported with a config file read from stdin", extendsKey)
+		}
+
+		return nil
+	}
+
+	file, err := l.loadSettingsFile(usedConfigFile, nil)
+	if err != nil {
+		return err
+	}
+
+	l.setFileSources(file.sources)
+
+	if !l.viper.IsSet(extendsKey) {
+		return nil
+	}
+
+	l.log.Infof("Config file %s extends %v", usedConfigFile, l.viper.Get(extendsKey))
+
+	return l.viper.MergeConfigMap(file.settings)
+}
+
 func (l *Loader) setConfigDir() error {
 	usedConfigFile := l.viper.ConfigFileUsed()
 	if usedConfigFile == "" {
@@ -264,56 +348,105 @@ func (l *Loader) applyStringSliceHack() {
 		return
 	}
 
-	l.appendStringSlice("enable", &l.cfg.Linters.Enable)
-	l.appendStringSlice("disable", &l.cfg.Linters.Disable)
-	l.appendStringSlice("presets", &l.cfg.Linters.Presets)
-	l.appendStringSlice("build-tags", &l.cfg.Run.BuildTags)
-	l.appendStringSlice("exclude", &l.cfg.Issues.ExcludePatterns)
+	l.appendStringSlice("enable", "linters.enable", &l.cfg.Linters.Enable)
+	l.appendStringSlice("disable", "linters.disable", &l.cfg.Linters.Disable)
+	l.appendStringSlice("presets", "linters.presets", &l.cfg.Linters.Presets)
+	l.appendStringSlice("build-tags", "run.build-tags", &l.cfg.Run.BuildTags)
+	l.appendStringSlice("exclude", "issues.exclude", &l.cfg.Issues.ExcludePatterns)
 
-	l.appendStringSlice("skip-dirs", &l.cfg.Run.SkipDirs)
-	l.appendStringSlice("skip-files", &l.cfg.Run.SkipFiles)
-	l.appendStringSlice("exclude-dirs", &l.cfg.Issues.ExcludeDirs)
-	l.appendStringSlice("exclude-files", &l.cfg.Issues.ExcludeFiles)
+	l.appendStringSlice("skip-dirs", "run.skip-dirs", &l.cfg.Run.SkipDirs)
+	l.appendStringSlice("skip-files", "run.skip-files", &l.cfg.Run.SkipFiles)
+	l.appendStringSlice("exclude-dirs", "issues.exclude-dirs", &l.cfg.Issues.ExcludeDirs)
+	l.appendStringSlice("exclude-files", "issues.exclude-files", &l.cfg.Issues.ExcludeFiles)
 }
 
-func (l *Loader) appendStringSlice(name string, current *[]string) {
+func (l *Loader) appendStringSlice(name, key string, current *[]string) {
 	if l.fs.Changed(name) {
 		val, _ := l.fs.GetStringSlice(name)
 		*current = append(*current, val...)
+
+		l.addSource(key, flagSource(name))
 	}
 }
 
 func (l *Loader) handleGoVersion() {
 	if l.cfg.Run.Go == "" {
-		l.cfg.Run.Go = detectGoVersion()
+		var source Source
+		l.cfg.Run.Go, source = detectGoVersion(l.moduleDir)
+
+		l.setSource("run.go", source)
 	}
 
-	l.cfg.LintersSettings.Govet.Go = l.cfg.Run.Go
+	setGoVersion(&l.cfg.LintersSettings, l.cfg.Run.Go)
+
+	os.Setenv("GOSECGOVERSION", l.cfg.Run.Go)
+}
 
-	l.cfg.LintersSettings.ParallelTest.Go = l.cfg.Run.Go
+// setGoVersion sets the Go version of the linters settings.
+func setGoVersion(settings *LintersSettings, goVersion string) {
+	settings.Govet.Go = goVersion
 
-	if l.cfg.LintersSettings.Gofumpt.LangVersion == "" {
-		l.cfg.LintersSettings.Gofumpt.LangVersion = l.cfg.Run.Go
+	settings.ParallelTest.Go = goVersion
+
+	if settings.Gofumpt.LangVersion == "" {
+		settings.Gofumpt.LangVersion = goVersion
 	}
 
-	trimmedGoVersion := goutil.TrimGoVersion(l.cfg.Run.Go)
+	trimmedGoVersion := goutil.TrimGoVersion(goVersion)
 
-	l.cfg.LintersSettings.Revive.Go = trimmedGoVersion
+	settings.Revive.Go = trimmedGoVersion
 
-	l.cfg.LintersSettings.Gocritic.Go = trimmedGoVersion
+	settings.Gocritic.Go = trimmedGoVersion
 
 	// staticcheck related linters.
-	if l.cfg.LintersSettings.Staticcheck.GoVersion == "" {
-		l.cfg.LintersSettings.Staticcheck.GoVersion = trimmedGoVersion
+	if settings.Staticcheck.GoVersion == "" {
+		settings.Staticcheck.GoVersion = trimmedGoVersion
+	}
+	if settings.Gosimple.GoVersion == "" {
+		settings.Gosimple.GoVersion = trimmedGoVersion
 	}
-	if l.cfg.LintersSettings.Gosimple.GoVersion == "" {
-		l.cfg.LintersSettings.Gosimple.GoVersion = trimmedGoVersion
+	if settings.Stylecheck.GoVersion == "" {
+		settings.Stylecheck.GoVersion = trimmedGoVersion
 	}
-	if l.cfg.LintersSettings.Stylecheck.GoVersion == "" {
-		l.cfg.LintersSettings.Stylecheck.GoVersion = trimmedGoVersion
+}
+
+// handleOverrides builds the configurations of the overrides (see Override.Config):
+// the linters settings of an override are merged into the linters settings of the run.
+func (l *Loader) handleOverrides() error {
+	for i := range l.cfg.Overrides {
+		o := &l.cfg.Overrides[i]
+
+		v := viper.New()
+
+		for _, raw := range []any{l.viper.Get("linters-settings"), o.LintersSettings} {
+			if settings, ok := raw.(map[string]any); ok && len(settings) > 0 {
+				err := v.MergeConfigMap(map[string]any{"linters-settings": settings})
+				if err != nil {
+					return fmt.Errorf("can't merge the linters settings of the override #%d: %w", i, err)
+				}
+			}
+		}
+
+		cfg := *l.cfg
+		cfg.LintersSettings = defaultLintersSettings
+		cfg.Overrides = nil
+
+		err := v.UnmarshalKey("linters-settings", &cfg.LintersSettings, customDecoderHook())
+		if err != nil {
+			return fmt.Errorf("can't unmarshal the linters settings of the override #%d: %w", i, err)
+		}
+
+		setGoVersion(&cfg.LintersSettings, cfg.Run.Go)
+
+		// The linters of `--enable-only` are the only linters of the run.
+		if l.fs == nil || !l.fs.Changed("enable-only") {
+			cfg.Linters = o.Linters.apply(&l.cfg.Linters)
+		}
+
+		o.cfg = &cfg
 	}
 
-	os.Setenv("GOSECGOVERSION", l.cfg.Run.Go)
+	return nil
 }
 
 func (l *Loader) handleDeprecation() error {
@@ -479,6 +612,8 @@ func (l *Loader) handleEnableOnlyOption() error {
 			Enable:     only,
 			DisableAll: true,
 		}
+
+		l.setSource("linters", flagSource("enable-only"))
 	}
 
 	return nil
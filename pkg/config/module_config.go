@@ -0,0 +1,108 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/pflag"
+)
+
+// moduleConfigFiles are the names of the configuration files of a module, in the order of the search of the configuration file.
+var moduleConfigFiles = []string{".golangci.json", ".golangci.toml", ".golangci.yaml", ".golangci.yml"}
+
+// bindFlags binds the flags to the keys of the configuration (see FlagKeyAnnotation).
+func (l *Loader) bindFlags() {
+	if l.fs == nil {
+		return
+	}
+
+	l.fs.VisitAll(func(f *pflag.Flag) {
+		keys := f.Annotations[FlagKeyAnnotation]
+		if len(keys) == 0 {
+			return
+		}
+
+		_ = l.viper.BindPFlag(keys[0], f)
+	})
+}
+
+// parseModuleConfig merges the configuration file of the run with the configuration file of the module.
+func (l *Loader) parseModuleConfig(runConfigFile string) error {
+	var file *settingsFile
+
+	if runConfigFile == os.Stdin.Name() {
+		return errors.New("a configuration read from stdin is not supported with several modules")
+	}
+
+	if runConfigFile != "" {
+		var err error
+
+		file, err = l.loadSettingsFile(runConfigFile, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	moduleConfigFile, err := l.findModuleConfigFile(runConfigFile)
+	if err != nil {
+		return err
+	}
+
+	if moduleConfigFile != "" {
+		l.log.Infof("Used module config file %s", moduleConfigFile)
+
+		moduleFile, errL := l.loadSettingsFile(moduleConfigFile, nil)
+		if errL != nil {
+			return errL
+		}
+
+		if file == nil {
+			file = moduleFile
+		} else {
+			file = mergeSettingsFiles(file, moduleFile)
+		}
+	}
+
+	if file != nil {
+		l.setFileSources(file.sources)
+
+		err = l.viper.MergeConfigMap(file.settings)
+		if err != nil {
+			return fmt.Errorf("can't merge the configuration of the module %s: %w", l.moduleDir, err)
+		}
+	}
+
+	err = l.viper.Unmarshal(l.cfg, customDecoderHook())
+	if err != nil {
+		return fmt.Errorf("can't unmarshal config of the module %s by viper (flags, file): %w", l.moduleDir, err)
+	}
+
+	return nil
+}
+
+// findModuleConfigFile returns the configuration file of the module directory, if it's not the configuration file of the run.
+func (l *Loader) findModuleConfigFile(runConfigFile string) (string, error) {
+	runConfigFile, err := filepath.Abs(runConfigFile)
+	if err != nil {
+		return "", err
+	}
+
+	for _, name := range moduleConfigFiles {
+		path := filepath.Join(l.moduleDir, name)
+
+		info, err := os.Stat(path)
+		if err != nil || info.IsDir() {
+			continue
+		}
+
+		if path == runConfigFile {
+			return "", nil
+		}
+
+		return path, nil
+	}
+
+	return "", nil
+}
@@ -0,0 +1,82 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+func TestLoader_LoadModule(t *testing.T) {
+	dir := t.TempDir()
+
+	rootFile := writeConfigFile(t, dir, ".golangci.yml", `
+run:
+  timeout: 5m
+  build-tags: [root]
+linters:
+  disable-all: true
+  enable: [gofmt, govet]
+linters-settings:
+  lll:
+    line-length: 100
+    tab-width: 2
+`)
+
+	moduleDir := filepath.Join(dir, "mod")
+	require.NoError(t, os.MkdirAll(moduleDir, 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(moduleDir, "go.mod"), []byte("module example.com/mod\n\ngo 1.21\n"), 0o600))
+
+	writeConfigFile(t, moduleDir, ".golangci.yml", `
+run:
+  timeout: 10m
+  concurrency: 2
+linters:
+  enable: [lll]
+linters-settings:
+  lll:
+    line-length: 140
+`)
+
+	v := viper.New()
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+
+	fs.Int("concurrency", 0, "")
+	require.NoError(t, v.BindPFlag("run.concurrency", fs.Lookup("concurrency")))
+	require.NoError(t, fs.SetAnnotation("concurrency", FlagKeyAnnotation, []string{"run.concurrency"}))
+	require.NoError(t, fs.Parse([]string{"--concurrency=3"}))
+
+	loader := NewLoader(logutils.NewStderrLog(logutils.DebugKeyEmpty), v, fs, LoaderOptions{Config: rootFile}, NewDefault(), nil)
+
+	require.NoError(t, loader.Load(LoadOptions{Validation: true}))
+
+	cfg, err := loader.LoadModule(moduleDir, LoadOptions{Validation: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, 10*time.Minute, cfg.Run.Timeout)
+	assert.Equal(t, 3, cfg.Run.Concurrency)
+	assert.Equal(t, []string{"root"}, cfg.Run.BuildTags)
+	assert.Equal(t, "1.21", cfg.Run.Go)
+	assert.True(t, cfg.Linters.DisableAll)
+	assert.Equal(t, []string{"lll", "gofmt", "govet"}, cfg.Linters.Enable)
+	assert.Equal(t, 140, cfg.LintersSettings.Lll.LineLength)
+	assert.Equal(t, 2, cfg.LintersSettings.Lll.TabWidth)
+
+	// The configuration of another module is the configuration of the run.
+	otherDir := filepath.Join(dir, "other")
+	require.NoError(t, os.MkdirAll(otherDir, 0o700))
+
+	cfg, err = loader.LoadModule(otherDir, LoadOptions{Validation: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, 5*time.Minute, cfg.Run.Timeout)
+	assert.Equal(t, []string{"gofmt", "govet"}, cfg.Linters.Enable)
+	assert.Equal(t, 100, cfg.LintersSettings.Lll.LineLength)
+}
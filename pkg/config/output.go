@@ -9,6 +9,7 @@ import (
 
 const (
 	OutFormatJSON              = "json"
+	OutFormatJSONStream        = "json-stream"
 	OutFormatLineNumber        = "line-number"
 	OutFormatColoredLineNumber = "colored-line-number"
 	OutFormatTab               = "tab"
@@ -25,6 +26,7 @@ const (
 
 var AllOutputFormats = []string{
 	OutFormatJSON,
+	OutFormatJSONStream,
 	OutFormatLineNumber,
 	OutFormatColoredLineNumber,
 	OutFormatTab,
@@ -0,0 +1,91 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Override is a configuration of the linters for the packages under some directories.
+type Override struct {
+	// Paths are the directories of the packages of the override, relative to the working directory.
+	Paths []string `mapstructure:"paths"`
+
+	Linters OverrideLinters `mapstructure:"linters"`
+
+	// LintersSettings are merged into the linters settings of the run.
+	LintersSettings map[string]any `mapstructure:"linters-settings"`
+
+	// cfg is the configuration of the packages of the override (see Loader.handleOverrides).
+	cfg *Config
+}
+
+// Config returns the configuration of the packages of the override: the configuration of the run,
+// with the linters and the linters settings of the override.
+// It's nil if the configuration was not loaded by a Loader.
+func (o *Override) Config() *Config {
+	return o.cfg
+}
+
+func (o *Override) Validate() error {
+	if len(o.Paths) == 0 {
+		return errors.New("no paths")
+	}
+
+	if err := o.Linters.Validate(); err != nil {
+		return err
+	}
+
+	if o.cfg == nil {
+		return nil
+	}
+
+	return o.cfg.LintersSettings.Validate()
+}
+
+// OverrideLinters are the linters enabled or disabled in addition to the linters of the run.
+type OverrideLinters struct {
+	Enable  []string `mapstructure:"enable"`
+	Disable []string `mapstructure:"disable"`
+}
+
+func (l *OverrideLinters) Validate() error {
+	for _, name := range l.Disable {
+		if slices.Contains(l.Enable, name) {
+			return fmt.Errorf("linter %q can't be disabled and enabled at one moment", name)
+		}
+	}
+
+	return nil
+}
+
+// apply returns the linters of the run, with the linters enabled or disabled by the override.
+func (l *OverrideLinters) apply(linters *Linters) Linters {
+	enabled := func(name string) bool { return slices.Contains(l.Enable, name) }
+	disabled := func(name string) bool { return slices.Contains(l.Disable, name) }
+
+	result := *linters
+
+	result.Enable = slices.DeleteFunc(slices.Clone(linters.Enable), disabled)
+	result.Disable = slices.DeleteFunc(slices.Clone(linters.Disable), enabled)
+
+	// The enabled linters are already in the set of the linters with `enable-all` (except the slow linters with `fast`).
+	if !linters.EnableAll || linters.Fast {
+		for _, name := range l.Enable {
+			if !slices.Contains(result.Enable, name) {
+				result.Enable = append(result.Enable, name)
+			}
+		}
+	}
+
+	// The disabled linters are not in the set of the linters with `disable-all`.
+	if !linters.DisableAll {
+		for _, name := range l.Disable {
+			if !slices.Contains(result.Disable, name) {
+				result.Disable = append(result.Disable, name)
+			}
+		}
+	}
+
+	return result
+}
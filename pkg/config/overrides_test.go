@@ -0,0 +1,133 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+func TestOverrideLinters_apply(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		override OverrideLinters
+		linters  Linters
+		expected Linters
+	}{
+		{
+			desc:     "enable and disable",
+			override: OverrideLinters{Enable: []string{"forbidigo", "gosec"}, Disable: []string{"lll", "dupl"}},
+			linters:  Linters{Enable: []string{"lll", "misspell"}, Disable: []string{"gosec"}},
+			expected: Linters{Enable: []string{"misspell", "forbidigo", "gosec"}, Disable: []string{"lll", "dupl"}},
+		},
+		{
+			desc:     "disable-all",
+			override: OverrideLinters{Enable: []string{"forbidigo"}, Disable: []string{"lll"}},
+			linters:  Linters{DisableAll: true, Enable: []string{"lll", "misspell"}},
+			expected: Linters{DisableAll: true, Enable: []string{"misspell", "forbidigo"}},
+		},
+		{
+			desc:     "enable-all",
+			override: OverrideLinters{Enable: []string{"lll"}, Disable: []string{"gosec"}},
+			linters:  Linters{EnableAll: true, Disable: []string{"lll", "dupl"}},
+			expected: Linters{EnableAll: true, Disable: []string{"dupl", "gosec"}},
+		},
+		{
+			desc:     "no override",
+			linters:  Linters{Enable: []string{"lll"}, Presets: []string{"bugs"}},
+			expected: Linters{Enable: []string{"lll"}, Presets: []string{"bugs"}},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, test.override.apply(&test.linters))
+		})
+	}
+}
+
+func TestLoader_Load_overrides(t *testing.T) {
+	dir := t.TempDir()
+
+	file := writeConfigFile(t, dir, ".golangci.yml", `
+run:
+  go: "1.21"
+linters:
+  disable-all: true
+  enable: [funlen, lll]
+linters-settings:
+  funlen:
+    lines: 40
+    statements: 30
+  lll:
+    line-length: 100
+overrides:
+  - paths: [internal/legacy]
+    linters:
+      enable: [gocognit]
+      disable: [lll]
+    linters-settings:
+      funlen:
+        lines: 100
+`)
+
+	cfg := NewDefault()
+
+	loader := NewLoader(logutils.NewStderrLog(logutils.DebugKeyEmpty), viper.New(), pflag.NewFlagSet("test", pflag.ContinueOnError),
+		LoaderOptions{Config: file}, cfg, nil)
+
+	require.NoError(t, loader.Load(LoadOptions{Validation: true}))
+
+	require.Len(t, cfg.Overrides, 1)
+
+	overrideCfg := cfg.Overrides[0].Config()
+	require.NotNil(t, overrideCfg)
+
+	assert.Equal(t, []string{"funlen", "gocognit"}, overrideCfg.Linters.Enable)
+	assert.Equal(t, 100, overrideCfg.LintersSettings.Funlen.Lines)
+	assert.Equal(t, 30, overrideCfg.LintersSettings.Funlen.Statements)
+	assert.Equal(t, 100, overrideCfg.LintersSettings.Lll.LineLength)
+	assert.Equal(t, "1.21", overrideCfg.LintersSettings.Govet.Go)
+	assert.Empty(t, overrideCfg.Overrides)
+
+	// The configuration of the run is unchanged.
+	assert.Equal(t, []string{"funlen", "lll"}, cfg.Linters.Enable)
+	assert.Equal(t, 40, cfg.LintersSettings.Funlen.Lines)
+}
+
+func TestOverride_Validate_error(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		override Override
+		expected string
+	}{
+		{
+			desc:     "no paths",
+			override: Override{Linters: OverrideLinters{Enable: []string{"lll"}}},
+			expected: "no paths",
+		},
+		{
+			desc: "enabled and disabled linter",
+			override: Override{
+				Paths:   []string{"cmd"},
+				Linters: OverrideLinters{Enable: []string{"lll"}, Disable: []string{"lll"}},
+			},
+			expected: `linter "lll" can't be disabled and enabled at one moment`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.override.Validate()
+			require.EqualError(t, err, test.expected)
+		})
+	}
+}
@@ -2,7 +2,9 @@ package config
 
 import (
 	"fmt"
+	"math"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -11,8 +13,12 @@ import (
 type Run struct {
 	Timeout time.Duration `mapstructure:"timeout"`
 
+	TimeoutPartialResults bool `mapstructure:"timeout-partial-results"`
+
 	Concurrency int `mapstructure:"concurrency"`
 
+	MemoryLimit string `mapstructure:"memory-limit"`
+
 	Go string `mapstructure:"go"`
 
 	BuildTags           []string `mapstructure:"build-tags"`
@@ -21,6 +27,10 @@ type Run struct {
 	ExitCodeIfIssuesFound int  `mapstructure:"issues-exit-code"`
 	AnalyzeTests          bool `mapstructure:"tests"`
 
+	ChangedSince string `mapstructure:"changed-since"`
+
+	Modules []string `mapstructure:"modules"`
+
 	AllowParallelRunners bool `mapstructure:"allow-parallel-runners"`
 	AllowSerialRunners   bool `mapstructure:"allow-serial-runners"`
 
@@ -43,5 +53,46 @@ func (r *Run) Validate() error {
 		return fmt.Errorf("invalid modules download path %s, only (%s) allowed", r.ModulesDownloadMode, strings.Join(allowedMods, "|"))
 	}
 
+	if r.MemoryLimit != "" {
+		if _, err := ParseMemoryLimit(r.MemoryLimit); err != nil {
+			return fmt.Errorf("invalid memory-limit: %w", err)
+		}
+	}
+
 	return nil
 }
+
+// memoryUnits are the units of the memory limits, as in GOMEMLIMIT.
+var memoryUnits = []struct {
+	suffix string
+	shift  int
+}{
+	{suffix: "TiB", shift: 40},
+	{suffix: "GiB", shift: 30},
+	{suffix: "MiB", shift: 20},
+	{suffix: "KiB", shift: 10},
+	{suffix: "B", shift: 0},
+}
+
+// ParseMemoryLimit parses a memory limit in bytes, with the syntax of GOMEMLIMIT (ex: 4GiB, 512MiB).
+func ParseMemoryLimit(value string) (int64, error) {
+	number, shift := value, 0
+
+	for _, unit := range memoryUnits {
+		if n, ok := strings.CutSuffix(value, unit.suffix); ok {
+			number, shift = n, unit.shift
+			break
+		}
+	}
+
+	n, err := strconv.ParseInt(number, 10, 64)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("%q is not a positive number of bytes, with an optional unit (B, KiB, MiB, GiB, TiB)", value)
+	}
+
+	if n > math.MaxInt64>>shift {
+		return 0, fmt.Errorf("%q is too large", value)
+	}
+
+	return n << shift, nil
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -35,6 +36,12 @@ func TestRun_Validate(t *testing.T) {
 				ModulesDownloadMode: "",
 			},
 		},
+		{
+			desc: "memory-limit",
+			settings: &Run{
+				MemoryLimit: "4GiB",
+			},
+		},
 	}
 
 	for _, test := range testCases {
@@ -60,6 +67,13 @@ func TestRun_Validate_error(t *testing.T) {
 			},
 			expected: "invalid modules download path invalid, only (mod|readonly|vendor) allowed",
 		},
+		{
+			desc: "memory-limit: invalid",
+			settings: &Run{
+				MemoryLimit: "4GB",
+			},
+			expected: `invalid memory-limit: "4GB" is not a positive number of bytes, with an optional unit (B, KiB, MiB, GiB, TiB)`,
+		},
 	}
 
 	for _, test := range testCases {
@@ -71,3 +85,41 @@ func TestRun_Validate_error(t *testing.T) {
 		})
 	}
 }
+
+func TestParseMemoryLimit(t *testing.T) {
+	testCases := []struct {
+		value    string
+		expected int64
+	}{
+		{value: "1024", expected: 1024},
+		{value: "1024B", expected: 1024},
+		{value: "2KiB", expected: 2 << 10},
+		{value: "512MiB", expected: 512 << 20},
+		{value: "8GiB", expected: 8 << 30},
+		{value: "1TiB", expected: 1 << 40},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.value, func(t *testing.T) {
+			t.Parallel()
+
+			limit, err := ParseMemoryLimit(test.value)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, limit)
+		})
+	}
+}
+
+func TestParseMemoryLimit_error(t *testing.T) {
+	testCases := []string{"", "0", "-1GiB", "1.5GiB", "GiB", "8 GiB", "9999999TiB"}
+
+	for _, value := range testCases {
+		t.Run(value, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseMemoryLimit(value)
+			require.Error(t, err)
+		})
+	}
+}
@@ -18,8 +18,8 @@ func (s *Severity) Validate() error {
 		return errors.New("can't set severity rule option: no default severity defined")
 	}
 
-	for i, rule := range s.Rules {
-		if err := rule.Validate(); err != nil {
+	for i := range s.Rules {
+		if err := s.Rules[i].Validate(); err != nil {
 			return fmt.Errorf("error in severity rule #%d: %w", i, err)
 		}
 	}
@@ -0,0 +1,87 @@
+package config
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// FlagKeyAnnotation is the annotation of the flags containing the key of the configuration value they set.
+const FlagKeyAnnotation = "golangci-lint/config-key"
+
+// SourceKind is the kind of the source of a configuration value.
+type SourceKind string
+
+const (
+	SourceDefault SourceKind = "default"
+	SourceFile    SourceKind = "file"
+	SourceFlag    SourceKind = "flag"
+	SourceEnv     SourceKind = "env"
+)
+
+// Source is a source of a configuration value.
+type Source struct {
+	Kind SourceKind `json:"kind" toml:"kind"`
+
+	// Names are the configuration files, the flag, or the environment variable, setting the value.
+	Names []string `json:"names,omitempty" toml:"names,omitempty"`
+}
+
+func (s Source) String() string {
+	if len(s.Names) == 0 {
+		return string(s.Kind)
+	}
+
+	return string(s.Kind) + ": " + strings.Join(s.Names, ", ")
+}
+
+// Sources returns the sources setting each value (key -> sources), ex: "linters.enable" -> [flag: --enable, file: .golangci.yml].
+// The sources are sorted by precedence.
+// The values without sources are the default values.
+func (l *Loader) Sources() map[string][]Source {
+	return l.sources
+}
+
+// setSource sets the source of a value: the sources of the values it contains are dropped.
+func (l *Loader) setSource(key string, source Source) {
+	for k := range l.sources {
+		if strings.HasPrefix(k, key+".") {
+			delete(l.sources, k)
+		}
+	}
+
+	l.sources[key] = []Source{source}
+}
+
+// addSource adds a source to a list combined with the values of the other sources.
+func (l *Loader) addSource(key string, source Source) {
+	l.sources[key] = slices.Insert(l.sources[key], 0, source)
+}
+
+// setFileSources sets the configuration files setting each value (key -> paths).
+func (l *Loader) setFileSources(files map[string][]string) {
+	for key, paths := range files {
+		l.sources[key] = []Source{{Kind: SourceFile, Names: paths}}
+	}
+}
+
+// setFlagSources sets the sources of the values set by the flags bound to the configuration.
+func (l *Loader) setFlagSources() {
+	if l.fs == nil {
+		return
+	}
+
+	l.fs.Visit(func(f *pflag.Flag) {
+		keys := f.Annotations[FlagKeyAnnotation]
+		if len(keys) == 0 {
+			return
+		}
+
+		l.setSource(keys[0], flagSource(f.Name))
+	})
+}
+
+func flagSource(name string) Source {
+	return Source{Kind: SourceFlag, Names: []string{"--" + name}}
+}
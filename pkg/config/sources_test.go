@@ -0,0 +1,90 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+func TestLoader_Sources(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		args     []string
+		expected func(path string) map[string][]Source
+	}{
+		{
+			desc: "file",
+			expected: func(path string) map[string][]Source {
+				return map[string][]Source{
+					"run.timeout":    {fileSource(path)},
+					"linters.enable": {fileSource(path)},
+					"linters.fast":   {fileSource(path)},
+				}
+			},
+		},
+		{
+			desc: "flags",
+			args: []string{"--timeout=2m", "--enable=gosec"},
+			expected: func(path string) map[string][]Source {
+				return map[string][]Source{
+					"run.timeout":    {flagSource("timeout")},
+					"linters.enable": {flagSource("enable"), fileSource(path)},
+					"linters.fast":   {fileSource(path)},
+				}
+			},
+		},
+		{
+			desc: "enable-only",
+			args: []string{"--enable-only=gosec"},
+			expected: func(path string) map[string][]Source {
+				return map[string][]Source{
+					"run.timeout": {fileSource(path)},
+					"linters":     {flagSource("enable-only")},
+				}
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			path := writeConfigFile(t, t.TempDir(), ".golangci.yml", `
+run:
+  timeout: 5m
+linters:
+  fast: true
+  enable:
+    - errcheck
+`)
+
+			v := viper.New()
+
+			fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			fs.Duration("timeout", 0, "")
+			fs.StringSlice("enable", nil, "")
+			fs.StringSlice("enable-only", nil, "")
+
+			require.NoError(t, v.BindPFlag("run.timeout", fs.Lookup("timeout")))
+			require.NoError(t, fs.SetAnnotation("timeout", FlagKeyAnnotation, []string{"run.timeout"}))
+
+			require.NoError(t, fs.Parse(test.args))
+
+			loader := NewLoader(logutils.NewStderrLog(logutils.DebugKeyEmpty), v, fs, LoaderOptions{Config: path}, NewDefault(), nil)
+
+			require.NoError(t, loader.Load(LoadOptions{}))
+
+			sources := loader.Sources()
+
+			// The source of the Go version depends on the environment.
+			delete(sources, "run.go")
+
+			assert.Equal(t, test.expected(path), sources)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/golangci/golangci-lint/pkg/exitcodes"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// ErrUnavailable is returned when no daemon is listening on the socket.
+var ErrUnavailable = errors.New("daemon unavailable")
+
+// Lint sends a request to the daemon listening on the socket, and returns the issues found by the daemon.
+// The warnings and the errors logged by the daemon are added to the report of the response.
+// The deadline of the context is sent with the request.
+func Lint(ctx context.Context, socketPath string, req *Request) ([]result.Issue, *Response, error) {
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "unix", socketPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrUnavailable, err)
+	}
+
+	defer func() { _ = conn.Close() }()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+
+		req.Deadline = deadline
+	}
+
+	err = json.NewEncoder(conn).Encode(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("can't send the request: %w", err)
+	}
+
+	resp := &Response{}
+
+	err = json.NewDecoder(conn).Decode(resp)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, nil, ctx.Err()
+		}
+
+		return nil, nil, fmt.Errorf("can't read the response: %w", err)
+	}
+
+	if resp.Error != "" {
+		return nil, resp, &exitcodes.ExitError{Message: resp.Error, Code: resp.ExitCode}
+	}
+
+	return resp.Issues, resp, nil
+}
@@ -0,0 +1,94 @@
+package daemon
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/golangci/golangci-lint/pkg/report"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// Request is sent by the client to analyze packages.
+// The requests and the responses are encoded in JSON.
+type Request struct {
+	// Dir is the working directory of the client: it must be the directory of the daemon.
+	Dir string
+
+	Args []string
+
+	// Flags are the flags set on the command line of the client (name -> values):
+	// the daemon loads the configuration as the client.
+	Flags map[string][]string `json:",omitempty"`
+
+	// Deadline is the deadline of the client (zero if none): the analysis is canceled after it.
+	Deadline time.Time
+}
+
+// Response contains the issues found by the daemon, or the error of the analysis.
+type Response struct {
+	Issues []result.Issue `json:",omitempty"`
+
+	Report report.Data
+
+	Error    string `json:",omitempty"`
+	ExitCode int    `json:",omitempty"`
+}
+
+// socketDirPerm allows only the user to connect to the daemons: a daemon analyzes the packages requested by its clients.
+const socketDirPerm = 0o700
+
+// SocketPath returns the path of the socket of the daemon running in a directory.
+// The sockets are inside a directory only accessible by the current user (created if needed):
+// `$XDG_RUNTIME_DIR/golangci-lint`, or `golangci-lint-daemon` inside the user cache directory.
+func SocketPath(dir string) (string, error) {
+	socketDir, err := userSocketDir()
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256([]byte(dir))
+
+	return filepath.Join(socketDir, fmt.Sprintf("%x.sock", sum[:8])), nil
+}
+
+func userSocketDir() (string, error) {
+	var socketDir string
+
+	if runtimeDir := os.Getenv("XDG_RUNTIME_DIR"); runtimeDir != "" {
+		socketDir = filepath.Join(runtimeDir, "golangci-lint")
+	} else {
+		cacheDir, err := os.UserCacheDir()
+		if err != nil {
+			return "", fmt.Errorf("can't get the directory of the sockets: %w", err)
+		}
+
+		socketDir = filepath.Join(cacheDir, "golangci-lint-daemon")
+	}
+
+	err := os.MkdirAll(socketDir, socketDirPerm)
+	if err != nil {
+		return "", fmt.Errorf("can't create the directory of the sockets: %w", err)
+	}
+
+	info, err := os.Lstat(socketDir)
+	if err != nil {
+		return "", fmt.Errorf("can't read the directory of the sockets: %w", err)
+	}
+
+	if !info.IsDir() {
+		return "", fmt.Errorf("%s is not a directory", socketDir)
+	}
+
+	// The directory can exist with other permissions (ex: created by a previous version, or with a umask).
+	if info.Mode().Perm() != socketDirPerm {
+		err = os.Chmod(socketDir, socketDirPerm)
+		if err != nil {
+			return "", fmt.Errorf("can't restrict the permissions of the directory of the sockets: %w", err)
+		}
+	}
+
+	return socketDir, nil
+}
@@ -0,0 +1,51 @@
+package daemon
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSocketPath(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the permissions of the directories are not supported on Windows")
+	}
+
+	runtimeDir := t.TempDir()
+	t.Setenv("XDG_RUNTIME_DIR", runtimeDir)
+
+	socketDir := filepath.Join(runtimeDir, "golangci-lint")
+
+	// A directory with other permissions is restricted.
+	require.NoError(t, os.Mkdir(socketDir, 0o755))
+
+	socketPath, err := SocketPath("/path/to/project")
+	require.NoError(t, err)
+
+	assert.Equal(t, socketDir, filepath.Dir(socketPath))
+
+	info, err := os.Stat(socketDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, os.FileMode(0o700), info.Mode().Perm())
+
+	// The socket depends on the directory of the daemon.
+	otherPath, err := SocketPath("/path/to/other")
+	require.NoError(t, err)
+
+	assert.NotEqual(t, socketPath, otherPath)
+}
+
+func TestSocketPath_notDirectory(t *testing.T) {
+	runtimeDir := t.TempDir()
+	t.Setenv("XDG_RUNTIME_DIR", runtimeDir)
+
+	require.NoError(t, os.WriteFile(filepath.Join(runtimeDir, "golangci-lint"), nil, 0o600))
+
+	_, err := SocketPath("/path/to/project")
+	require.Error(t, err)
+}
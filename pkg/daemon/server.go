@@ -0,0 +1,341 @@
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/golangci/golangci-lint/pkg/exitcodes"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/report"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// Linter analyzes the packages of the requests.
+type Linter interface {
+	// Lint analyzes the packages of a request.
+	// The warnings and the errors of the analysis are logged into the report data.
+	Lint(ctx context.Context, req *Request, reportData *report.Data) ([]result.Issue, error)
+
+	// Invalidate is called with the files (absolute paths) changed since the previous analysis.
+	Invalidate(files []string)
+
+	// Reset is called when the changes of the files are unknown (ex: the events of the watcher have been lost).
+	Reset()
+}
+
+// Server watches the files of a directory and serves the requests of the clients on a unix socket.
+// The requests are processed one at a time.
+// A request is canceled when its client disconnects, or after the deadline of the client.
+type Server struct {
+	log    logutils.Log
+	linter Linter
+	dir    string
+
+	// lintSem serializes the runs: the requests waiting for the run of another request can be canceled.
+	lintSem chan struct{}
+
+	mu      sync.Mutex
+	changed map[string]bool
+	lost    bool
+}
+
+// NewServer creates a new Server watching the files of the directory.
+func NewServer(log logutils.Log, linter Linter, dir string) *Server {
+	return &Server{
+		log:     log,
+		linter:  linter,
+		dir:     dir,
+		lintSem: make(chan struct{}, 1),
+		changed: map[string]bool{},
+	}
+}
+
+// Run listens on the socket until the context is canceled.
+func (s *Server) Run(ctx context.Context, socketPath string) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("can't create the files watcher: %w", err)
+	}
+
+	defer func() { _ = watcher.Close() }()
+
+	err = s.watch(watcher, s.dir, false)
+	if err != nil {
+		return err
+	}
+
+	listener, err := listen(ctx, socketPath)
+	if err != nil {
+		return err
+	}
+
+	s.log.Infof("Listening on %s", socketPath)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		<-ctx.Done()
+		_ = listener.Close()
+	}()
+
+	go s.watchLoop(ctx, watcher)
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return fmt.Errorf("can't accept connection: %w", err)
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			s.serve(ctx, conn)
+		}()
+	}
+}
+
+func (s *Server) serve(ctx context.Context, conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+
+	req := &Request{}
+
+	err := json.NewDecoder(conn).Decode(req)
+	if err != nil {
+		s.log.Warnf("Can't read the request: %v", err)
+		return
+	}
+
+	ctx, cancel := requestContext(ctx, conn, req)
+	defer cancel()
+
+	resp := s.lint(ctx, req)
+
+	err = json.NewEncoder(conn).Encode(resp)
+	if err != nil {
+		s.log.Warnf("Can't send the response: %v", err)
+	}
+}
+
+// requestContext returns the context of a request:
+// it's canceled when the client disconnects, or after the deadline of the client.
+func requestContext(ctx context.Context, conn net.Conn, req *Request) (context.Context, context.CancelFunc) {
+	cancelDeadline := context.CancelFunc(func() {})
+	if !req.Deadline.IsZero() {
+		ctx, cancelDeadline = context.WithDeadline(ctx, req.Deadline)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	go func() {
+		// The client sends nothing after the request: the read returns when the connection is closed.
+		_, _ = io.Copy(io.Discard, conn)
+
+		cancel()
+	}()
+
+	return ctx, func() {
+		cancel()
+		cancelDeadline()
+	}
+}
+
+func (s *Server) lint(ctx context.Context, req *Request) *Response {
+	select {
+	case s.lintSem <- struct{}{}:
+	case <-ctx.Done():
+		return &Response{Error: fmt.Sprintf("the request is canceled: %v", ctx.Err()), ExitCode: exitcodes.Timeout}
+	}
+
+	defer func() { <-s.lintSem }()
+
+	if req.Dir != s.dir {
+		return &Response{
+			Error:    fmt.Sprintf("the daemon runs in %s, not in %s", s.dir, req.Dir),
+			ExitCode: exitcodes.Failure,
+		}
+	}
+
+	changed, lost := s.takeChanged()
+
+	switch {
+	case lost:
+		s.log.Infof("Files events lost: resetting the caches")
+		s.linter.Reset()
+
+	case len(changed) > 0:
+		s.log.Infof("%d files changed", len(changed))
+		s.linter.Invalidate(changed)
+	}
+
+	resp := &Response{}
+
+	issues, err := s.linter.Lint(ctx, req, &resp.Report)
+	if err != nil {
+		resp.Error = err.Error()
+		resp.ExitCode = exitcodes.Failure
+
+		var exitErr *exitcodes.ExitError
+		if errors.As(err, &exitErr) {
+			resp.ExitCode = exitErr.Code
+		}
+
+		return resp
+	}
+
+	resp.Issues = issues
+
+	return resp
+}
+
+func (s *Server) watchLoop(ctx context.Context, watcher *fsnotify.Watcher) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+
+			s.handleEvent(watcher, event)
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+
+			// The events can be lost (ex: queue overflow).
+			s.log.Warnf("Files watcher: %v", err)
+
+			s.mu.Lock()
+			s.lost = true
+			s.mu.Unlock()
+		}
+	}
+}
+
+func (s *Server) handleEvent(watcher *fsnotify.Watcher, event fsnotify.Event) {
+	if event.Op == fsnotify.Chmod {
+		return
+	}
+
+	if event.Op&fsnotify.Create != 0 {
+		info, err := os.Stat(event.Name)
+		if err == nil && info.IsDir() {
+			// The files can be created before the directory is watched.
+			if err := s.watch(watcher, event.Name, true); err != nil {
+				s.log.Warnf("%v", err)
+			}
+		}
+	}
+
+	s.addChanged(event.Name)
+}
+
+// watch watches a directory and its subdirectories, except the directories ignored by the go command.
+// The files of a new directory are considered as changed.
+func (s *Server) watch(watcher *fsnotify.Watcher, root string, created bool) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if path == root {
+				return fmt.Errorf("can't watch %s: %w", path, err)
+			}
+
+			// The directory can be removed during the walk.
+			return nil
+		}
+
+		if !d.IsDir() {
+			if created {
+				s.addChanged(path)
+			}
+
+			return nil
+		}
+
+		if path != s.dir && isIgnoredDir(d.Name()) {
+			return filepath.SkipDir
+		}
+
+		err = watcher.Add(path)
+		if err != nil {
+			return fmt.Errorf("can't watch %s: %w", path, err)
+		}
+
+		return nil
+	})
+}
+
+func (s *Server) addChanged(path string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.changed[path] = true
+}
+
+func (s *Server) takeChanged() (changed []string, lost bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	changed = make([]string, 0, len(s.changed))
+	for path := range s.changed {
+		changed = append(changed, path)
+	}
+
+	lost = s.lost
+
+	s.changed = map[string]bool{}
+	s.lost = false
+
+	return changed, lost
+}
+
+// listen listens on the socket, and removes the socket of a previous daemon that didn't stop properly.
+func listen(ctx context.Context, socketPath string) (net.Listener, error) {
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "unix", socketPath)
+	if err == nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("a daemon is already listening on %s", socketPath)
+	}
+
+	if _, err = os.Stat(socketPath); err == nil {
+		_ = os.Remove(socketPath)
+	}
+
+	var lc net.ListenConfig
+
+	listener, err := lc.Listen(ctx, "unix", socketPath)
+	if err != nil {
+		return nil, fmt.Errorf("can't listen on %s: %w", socketPath, err)
+	}
+
+	return listener, nil
+}
+
+func isIgnoredDir(name string) bool {
+	return name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")
+}
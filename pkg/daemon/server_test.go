@@ -0,0 +1,263 @@
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"go/token"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/exitcodes"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/report"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type fakeLinter struct {
+	mu          sync.Mutex
+	invalidated []string
+}
+
+func (l *fakeLinter) Lint(_ context.Context, req *Request, reportData *report.Data) ([]result.Issue, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if len(req.Args) == 0 {
+		return nil, &exitcodes.ExitError{Message: "no args", Code: exitcodes.NoGoFiles}
+	}
+
+	reportData.Warnings = append(reportData.Warnings, report.Warning{Tag: "test", Text: "warning"})
+
+	return []result.Issue{{
+		FromLinter: "test",
+		Text:       "issue",
+		Pos:        token.Position{Filename: "a.go", Line: 1},
+	}}, nil
+}
+
+func (l *fakeLinter) Invalidate(files []string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.invalidated = append(l.invalidated, files...)
+}
+
+func (*fakeLinter) Reset() {}
+
+func (l *fakeLinter) getInvalidated() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.invalidated
+}
+
+func TestServer(t *testing.T) {
+	dir := t.TempDir()
+
+	linter := &fakeLinter{}
+
+	socketPath := startServer(t, linter, dir)
+
+	ctx := context.Background()
+
+	issues, resp, err := Lint(ctx, socketPath, &Request{Dir: dir, Args: []string{"./..."}})
+	require.NoError(t, err)
+
+	require.Len(t, issues, 1)
+	assert.Equal(t, "issue", issues[0].Text)
+	assert.Equal(t, []report.Warning{{Tag: "test", Text: "warning"}}, resp.Report.Warnings)
+
+	file := filepath.Join(dir, "a.go")
+	require.NoError(t, os.WriteFile(file, []byte("package a\n"), 0o600))
+
+	// The events of the watcher are asynchronous.
+	assert.Eventually(t, func() bool {
+		_, _, err = Lint(ctx, socketPath, &Request{Dir: dir, Args: []string{"./..."}})
+		require.NoError(t, err)
+
+		return len(linter.getInvalidated()) > 0
+	}, 5*time.Second, 50*time.Millisecond)
+
+	assert.Contains(t, linter.getInvalidated(), file)
+}
+
+func TestServer_error(t *testing.T) {
+	dir := t.TempDir()
+
+	socketPath := startServer(t, &fakeLinter{}, dir)
+
+	testCases := []struct {
+		desc     string
+		req      *Request
+		expected *exitcodes.ExitError
+	}{
+		{
+			desc:     "linter error",
+			req:      &Request{Dir: dir},
+			expected: &exitcodes.ExitError{Message: "no args", Code: exitcodes.NoGoFiles},
+		},
+		{
+			desc: "other directory",
+			req:  &Request{Dir: filepath.Join(dir, "other"), Args: []string{"./..."}},
+			expected: &exitcodes.ExitError{
+				Message: "the daemon runs in " + dir + ", not in " + filepath.Join(dir, "other"),
+				Code:    exitcodes.Failure,
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			_, _, err := Lint(context.Background(), socketPath, test.req)
+
+			var exitErr *exitcodes.ExitError
+			require.ErrorAs(t, err, &exitErr)
+
+			assert.Equal(t, test.expected, exitErr)
+		})
+	}
+}
+
+// blockingLinter blocks the requests with the argument "block" until they are canceled.
+type blockingLinter struct {
+	canceled chan error
+}
+
+func (l *blockingLinter) Lint(ctx context.Context, req *Request, _ *report.Data) ([]result.Issue, error) {
+	if len(req.Args) > 0 && req.Args[0] == "block" {
+		<-ctx.Done()
+
+		l.canceled <- ctx.Err()
+
+		return nil, ctx.Err()
+	}
+
+	return []result.Issue{{FromLinter: "test", Text: "issue"}}, nil
+}
+
+func (*blockingLinter) Invalidate([]string) {}
+
+func (*blockingLinter) Reset() {}
+
+func TestServer_canceled(t *testing.T) {
+	dir := t.TempDir()
+
+	linter := &blockingLinter{canceled: make(chan error, 1)}
+
+	socketPath := startServer(t, linter, dir)
+
+	testCases := []struct {
+		desc     string
+		send     func(t *testing.T)
+		expected error
+	}{
+		{
+			desc: "client disconnected",
+			send: func(t *testing.T) {
+				t.Helper()
+
+				var dialer net.Dialer
+
+				conn, err := dialer.DialContext(context.Background(), "unix", socketPath)
+				require.NoError(t, err)
+
+				require.NoError(t, json.NewEncoder(conn).Encode(&Request{Dir: dir, Args: []string{"block"}}))
+
+				require.NoError(t, conn.Close())
+			},
+			expected: context.Canceled,
+		},
+		{
+			desc: "client deadline",
+			send: func(t *testing.T) {
+				t.Helper()
+
+				var dialer net.Dialer
+
+				conn, err := dialer.DialContext(context.Background(), "unix", socketPath)
+				require.NoError(t, err)
+
+				defer func() { _ = conn.Close() }()
+
+				req := &Request{Dir: dir, Args: []string{"block"}, Deadline: time.Now().Add(100 * time.Millisecond)}
+				require.NoError(t, json.NewEncoder(conn).Encode(req))
+
+				// The connection is still open when the analysis is canceled.
+				resp := &Response{}
+				require.NoError(t, json.NewDecoder(conn).Decode(resp))
+
+				assert.Contains(t, resp.Error, context.DeadlineExceeded.Error())
+			},
+			expected: context.DeadlineExceeded,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			test.send(t)
+
+			select {
+			case err := <-linter.canceled:
+				require.ErrorIs(t, err, test.expected)
+
+			case <-time.After(5 * time.Second):
+				require.FailNow(t, "the analysis is not canceled")
+			}
+
+			// The next requests are not blocked.
+			issues, _, err := Lint(context.Background(), socketPath, &Request{Dir: dir, Args: []string{"./..."}})
+			require.NoError(t, err)
+
+			assert.Len(t, issues, 1)
+		})
+	}
+}
+
+func TestLint_unavailable(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := Lint(context.Background(), filepath.Join(t.TempDir(), "missing.sock"), &Request{})
+
+	assert.ErrorIs(t, err, ErrUnavailable)
+}
+
+// startServer starts a server, and returns the path of its socket.
+func startServer(t *testing.T, linter Linter, dir string) string {
+	t.Helper()
+
+	// The path of a unix socket is limited to about 100 bytes.
+	socketDir, err := os.MkdirTemp("", "gl")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = os.RemoveAll(socketDir) })
+
+	socketPath := filepath.Join(socketDir, "test.sock")
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	server := NewServer(logutils.NewStderrLog(logutils.DebugKeyEmpty), linter, dir)
+
+	done := make(chan error, 1)
+
+	go func() { done <- server.Run(ctx, socketPath) }()
+
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-done)
+	})
+
+	require.Eventually(t, func() bool {
+		_, err := os.Stat(socketPath)
+		return !errors.Is(err, os.ErrNotExist)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	return socketPath
+}
@@ -31,6 +31,16 @@ func (fc *FileCache) GetFileBytes(filePath string) ([]byte, error) {
 	return fileBytes, nil
 }
 
+// SetFileBytes replaces the content of a file inside the cache (e.g. with the unsaved content of an editor).
+func (fc *FileCache) SetFileBytes(filePath string, fileBytes []byte) {
+	fc.files.Store(filePath, fileBytes)
+}
+
+// Invalidate removes a file from the cache: the next read will use the content on the disk.
+func (fc *FileCache) Invalidate(filePath string) {
+	fc.files.Delete(filePath)
+}
+
 func PrettifyBytesCount(n int64) string {
 	const (
 		Multiplexer = 1024
@@ -23,10 +23,13 @@ func NewIssue(issue *result.Issue, pass *analysis.Pass) Issue {
 type EncodingIssue struct {
 	FromLinter           string
 	Text                 string
+	RuleID               string
 	Severity             string
 	Pos                  token.Position
+	End                  *result.Position
 	LineRange            *result.Range
 	Replacement          *result.Replacement
+	SuggestedFixes       []result.SuggestedFix
 	ExpectNoLint         bool
 	ExpectedNoLintLinter string
 }
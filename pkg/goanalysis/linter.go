@@ -55,12 +55,12 @@ func NewLinter(name, desc string, analyzers []*analysis.Analyzer, cfg map[string
 	return &Linter{name: name, desc: desc, analyzers: analyzers, cfg: cfg}
 }
 
-func (lnt *Linter) Run(_ context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+func (lnt *Linter) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
 	if err := lnt.preRun(lintCtx); err != nil {
 		return nil, err
 	}
 
-	return runAnalyzers(lnt, lintCtx)
+	return runAnalyzers(ctx, lnt, lintCtx)
 }
 
 func (lnt *Linter) UseOriginalPackages() {
@@ -160,6 +160,12 @@ func (lnt *Linter) preRun(lintCtx *linter.Context) error {
 	return nil
 }
 
+// UsesFacts returns true if the issues of a package depend on the analysis of its dependencies:
+// the analyzers (or the analyzers they require) use facts, or the linter analyzes the whole program.
+func (lnt *Linter) UsesFacts() bool {
+	return lnt.loadMode == LoadModeWholeProgram || usesFacts(lnt.analyzers)
+}
+
 func (lnt *Linter) getName() string {
 	return lnt.name
 }
@@ -187,6 +193,30 @@ func (lnt *Linter) getLoadMode() LoadMode {
 	return lnt.loadMode
 }
 
+func usesFacts(analyzers []*analysis.Analyzer) bool {
+	visited := map[*analysis.Analyzer]bool{}
+
+	var visit func(analyzers []*analysis.Analyzer) bool
+
+	visit = func(analyzers []*analysis.Analyzer) bool {
+		for _, a := range analyzers {
+			if visited[a] {
+				continue
+			}
+
+			visited[a] = true
+
+			if len(a.FactTypes) > 0 || visit(a.Requires) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return visit(analyzers)
+}
+
 func allFlagNames(fs *flag.FlagSet) []string {
 	var ret []string
 	fs.VisitAll(func(f *flag.Flag) {
@@ -0,0 +1,10 @@
+//go:build !unix
+
+package profile
+
+import "time"
+
+// cpuTime isn't available on this platform.
+func cpuTime() time.Duration {
+	return 0
+}
@@ -0,0 +1,183 @@
+package profile
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"runtime/metrics"
+	"slices"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+const allocsMetric = "/gc/heap/allocs:bytes"
+
+const reportFileMode = 0o644
+
+// Cost is the cost of a measured operation.
+// The durations are in nanoseconds in the JSON report.
+type Cost struct {
+	Wall time.Duration
+	// CPU is the CPU time of the process (zero if it's not available on the platform).
+	CPU        time.Duration `json:",omitempty"`
+	AllocBytes uint64
+}
+
+// Action is the cost of an analyzer on a package.
+type Action struct {
+	Analyzer string
+	Package  string
+	Cost
+}
+
+// Load is the cost of the loading of a package (from the sources or from the export data) and of its facts.
+type Load struct {
+	Package string
+	Cost
+}
+
+// Report contains the costs of the actions and of the loadings, sorted by decreasing wall time.
+type Report struct {
+	Actions []Action
+	Loads   []Load
+}
+
+// Profiler measures the cost of the analyzers on each package.
+//
+// The measured operations run one at a time:
+// the CPU time and the allocated bytes are measured for the whole process, and attributed to the running operation.
+// The profiled runs are slower, but the costs are comparable.
+type Profiler struct {
+	// runMu serializes the measured operations.
+	runMu sync.Mutex
+
+	mu      sync.Mutex
+	actions []Action
+	loads   []Load
+}
+
+// NewProfiler creates a new Profiler.
+func NewProfiler() *Profiler {
+	return &Profiler{}
+}
+
+// TrackAction runs an analyzer on a package and records its cost.
+// A nil Profiler only runs the function.
+func (p *Profiler) TrackAction(analyzer, pkg string, f func()) {
+	if p == nil {
+		f()
+		return
+	}
+
+	cost := p.measure(f)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.actions = append(p.actions, Action{Analyzer: analyzer, Package: pkg, Cost: cost})
+}
+
+// TrackLoad loads a package and records its cost.
+// A nil Profiler only runs the function.
+func (p *Profiler) TrackLoad(pkg string, f func()) {
+	if p == nil {
+		f()
+		return
+	}
+
+	cost := p.measure(f)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.loads = append(p.loads, Load{Package: pkg, Cost: cost})
+}
+
+func (p *Profiler) measure(f func()) Cost {
+	p.runMu.Lock()
+	defer p.runMu.Unlock()
+
+	samples := []metrics.Sample{{Name: allocsMetric}}
+
+	metrics.Read(samples)
+	allocs := samples[0].Value.Uint64()
+
+	cpu := cpuTime()
+	startedAt := time.Now()
+
+	f()
+
+	wall := time.Since(startedAt)
+
+	metrics.Read(samples)
+
+	return Cost{
+		Wall:       wall,
+		CPU:        cpuTime() - cpu,
+		AllocBytes: samples[0].Value.Uint64() - allocs,
+	}
+}
+
+// Report returns the recorded costs.
+func (p *Profiler) Report() *Report {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	report := &Report{
+		Actions: slices.Clone(p.actions),
+		Loads:   slices.Clone(p.loads),
+	}
+
+	slices.SortStableFunc(report.Actions, func(a, b Action) int {
+		return cmp.Compare(b.Wall, a.Wall)
+	})
+
+	slices.SortStableFunc(report.Loads, func(a, b Load) int {
+		return cmp.Compare(b.Wall, a.Wall)
+	})
+
+	return report
+}
+
+// WriteFile writes the report in JSON.
+func (r *Report) WriteFile(path string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), reportFileMode)
+}
+
+// PrintSlowest prints a table of the slowest analyzer/package pairs.
+func (r *Report) PrintSlowest(w io.Writer, limit int) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	_, _ = fmt.Fprintln(tw, "ANALYZER\tPACKAGE\tWALL\tCPU\tALLOCATED")
+
+	for _, act := range r.Actions[:min(limit, len(r.Actions))] {
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", act.Analyzer, act.Package,
+			act.Wall.Round(time.Millisecond), act.CPU.Round(time.Millisecond), formatBytes(act.AllocBytes))
+	}
+
+	return tw.Flush()
+}
+
+func formatBytes(b uint64) string {
+	const unit = 1024
+
+	if b < unit {
+		return fmt.Sprintf("%d B", b)
+	}
+
+	div, exp := uint64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(b)/float64(div), "KMGTPE"[exp])
+}
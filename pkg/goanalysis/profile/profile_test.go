@@ -0,0 +1,120 @@
+package profile
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var sink []byte
+
+func TestProfiler(t *testing.T) {
+	t.Parallel()
+
+	p := NewProfiler()
+
+	p.TrackLoad("example.com/a", func() {})
+	p.TrackAction("fast", "example.com/a", func() {})
+	p.TrackAction("slow", "example.com/a", func() {
+		sink = make([]byte, 1<<20)
+		time.Sleep(10 * time.Millisecond)
+	})
+
+	report := p.Report()
+
+	require.Len(t, report.Actions, 2)
+	assert.Equal(t, "slow", report.Actions[0].Analyzer)
+	assert.Equal(t, "example.com/a", report.Actions[0].Package)
+	assert.GreaterOrEqual(t, report.Actions[0].Wall, 10*time.Millisecond)
+	assert.GreaterOrEqual(t, report.Actions[0].AllocBytes, uint64(1<<20))
+	assert.Equal(t, "fast", report.Actions[1].Analyzer)
+
+	require.Len(t, report.Loads, 1)
+	assert.Equal(t, "example.com/a", report.Loads[0].Package)
+}
+
+func TestProfiler_nil(t *testing.T) {
+	t.Parallel()
+
+	var p *Profiler
+
+	var called int
+
+	p.TrackLoad("example.com/a", func() { called++ })
+	p.TrackAction("test", "example.com/a", func() { called++ })
+
+	assert.Equal(t, 2, called)
+}
+
+func TestReport_WriteFile(t *testing.T) {
+	t.Parallel()
+
+	report := &Report{
+		Actions: []Action{{Analyzer: "test", Package: "example.com/a", Cost: Cost{Wall: time.Second, AllocBytes: 1}}},
+		Loads:   []Load{{Package: "example.com/a", Cost: Cost{Wall: time.Millisecond}}},
+	}
+
+	path := filepath.Join(t.TempDir(), "report.json")
+
+	require.NoError(t, report.WriteFile(path))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var actual Report
+	require.NoError(t, json.Unmarshal(data, &actual))
+
+	assert.Equal(t, report, &actual)
+}
+
+func TestReport_PrintSlowest(t *testing.T) {
+	t.Parallel()
+
+	report := &Report{
+		Actions: []Action{
+			{Analyzer: "buildir", Package: "example.com/a", Cost: Cost{Wall: 1500 * time.Millisecond, CPU: time.Second, AllocBytes: 3 << 20}},
+			{Analyzer: "errcheck", Package: "example.com/b", Cost: Cost{Wall: 20 * time.Millisecond, AllocBytes: 2048}},
+			{Analyzer: "unused", Package: "example.com/a", Cost: Cost{Wall: time.Millisecond}},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+
+	require.NoError(t, report.PrintSlowest(buf, 2))
+
+	expected := `ANALYZER  PACKAGE        WALL  CPU  ALLOCATED
+buildir   example.com/a  1.5s  1s   3.0 MiB
+errcheck  example.com/b  20ms  0s   2.0 KiB
+`
+
+	assert.Equal(t, expected, buf.String())
+}
+
+func Test_formatBytes(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		value    uint64
+		expected string
+	}{
+		{value: 0, expected: "0 B"},
+		{value: 1023, expected: "1023 B"},
+		{value: 1024, expected: "1.0 KiB"},
+		{value: 1536, expected: "1.5 KiB"},
+		{value: 5 << 30, expected: "5.0 GiB"},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.expected, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, formatBytes(test.value))
+		})
+	}
+}
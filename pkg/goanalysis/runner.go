@@ -10,10 +10,13 @@
 package goanalysis
 
 import (
+	"context"
 	"encoding/gob"
+	"errors"
 	"fmt"
 	"go/token"
 	"runtime"
+	"slices"
 	"sort"
 	"sync"
 
@@ -24,6 +27,7 @@ import (
 	"github.com/golangci/golangci-lint/internal/errorutil"
 	"github.com/golangci/golangci-lint/internal/pkgcache"
 	"github.com/golangci/golangci-lint/pkg/goanalysis/load"
+	"github.com/golangci/golangci-lint/pkg/goanalysis/profile"
 	"github.com/golangci/golangci-lint/pkg/logutils"
 	"github.com/golangci/golangci-lint/pkg/timeutils"
 )
@@ -54,20 +58,27 @@ type runner struct {
 	prefix         string // ensure unique analyzer names
 	pkgCache       *pkgcache.Cache
 	loadGuard      *load.Guard
+	overlay        map[string][]byte
+	profiler       *profile.Profiler
 	loadMode       LoadMode
 	passToPkg      map[*analysis.Pass]*packages.Package
 	passToPkgGuard sync.Mutex
 	sw             *timeutils.Stopwatch
+
+	// canceledPkgs are the initial packages not analyzed before the cancellation of the analysis.
+	canceledPkgs map[*packages.Package]bool
 }
 
 func newRunner(prefix string, logger logutils.Log, pkgCache *pkgcache.Cache, loadGuard *load.Guard,
-	loadMode LoadMode, sw *timeutils.Stopwatch,
+	overlay map[string][]byte, profiler *profile.Profiler, loadMode LoadMode, sw *timeutils.Stopwatch,
 ) *runner {
 	return &runner{
 		prefix:    prefix,
 		log:       logger,
 		pkgCache:  pkgCache,
 		loadGuard: loadGuard,
+		overlay:   overlay,
+		profiler:  profiler,
 		loadMode:  loadMode,
 		passToPkg: map[*analysis.Pass]*packages.Package{},
 		sw:        sw,
@@ -80,19 +91,50 @@ func newRunner(prefix string, logger logutils.Log, pkgCache *pkgcache.Cache, loa
 // It provides most of the logic for the main functions of both the
 // singlechecker and the multi-analysis commands.
 // It returns the appropriate exit code.
-func (r *runner) run(analyzers []*analysis.Analyzer, initialPackages []*packages.Package) ([]Diagnostic,
+//
+// When the context is canceled, the new actions are not started,
+// and only the diagnostics of the packages analyzed before the cancellation are returned.
+func (r *runner) run(ctx context.Context, analyzers []*analysis.Analyzer, initialPackages []*packages.Package) ([]Diagnostic,
 	[]error, map[*analysis.Pass]*packages.Package,
 ) {
 	debugf("Analyzing %d packages on load mode %s", len(initialPackages), r.loadMode)
 	defer r.pkgCache.Trim()
 
-	roots := r.analyze(initialPackages, analyzers)
+	roots := r.analyze(ctx, initialPackages, analyzers)
+
+	if ctx.Err() != nil {
+		roots = r.removeCanceledRoots(roots)
+	}
 
 	diags, errs := extractDiagnostics(roots)
 
 	return diags, errs, r.passToPkg
 }
 
+// removeCanceledRoots removes the root actions of the packages not analyzed before the cancellation.
+func (r *runner) removeCanceledRoots(roots []*action) []*action {
+	r.canceledPkgs = map[*packages.Package]bool{}
+
+	for _, act := range roots {
+		if errors.Is(act.err, context.Canceled) || errors.Is(act.err, context.DeadlineExceeded) {
+			r.canceledPkgs[act.pkg] = true
+		}
+	}
+
+	if len(r.canceledPkgs) > 0 {
+		debugf("%d packages were not analyzed before the cancellation", len(r.canceledPkgs))
+	}
+
+	return slices.DeleteFunc(roots, func(act *action) bool {
+		return r.canceledPkgs[act.pkg]
+	})
+}
+
+// isCanceled returns true if the package was not analyzed before the cancellation of the analysis.
+func (r *runner) isCanceled(pkg *packages.Package) bool {
+	return r.canceledPkgs[pkg]
+}
+
 type actKey struct {
 	*analysis.Analyzer
 	*packages.Package
@@ -221,7 +263,7 @@ func (r *runner) prepareAnalysis(pkgs []*packages.Package,
 	return initialPkgs, allActions, roots
 }
 
-func (r *runner) analyze(pkgs []*packages.Package, analyzers []*analysis.Analyzer) []*action {
+func (r *runner) analyze(ctx context.Context, pkgs []*packages.Package, analyzers []*analysis.Analyzer) []*action {
 	initialPkgs, actions, rootActions := r.prepareAnalysis(pkgs, analyzers)
 
 	actionPerPkg := map[*packages.Package][]*action{}
@@ -252,6 +294,9 @@ func (r *runner) analyze(pkgs []*packages.Package, analyzers []*analysis.Analyze
 			log:        r.log,
 			actions:    actionPerPkg[pkg],
 			loadGuard:  r.loadGuard,
+			overlay:    r.overlay,
+			profiler:   r.profiler,
+			done:       make(chan struct{}),
 			dependents: 1, // self dependent
 		}
 	}
@@ -261,21 +306,22 @@ func (r *runner) analyze(pkgs []*packages.Package, analyzers []*analysis.Analyze
 
 	// Limit memory and IO usage.
 	gomaxprocs := runtime.GOMAXPROCS(-1)
-	debugf("Analyzing at most %d packages in parallel", gomaxprocs)
-	loadSem := make(chan struct{}, gomaxprocs)
+	scheduler := newLoadScheduler(r.log, gomaxprocs)
+	debugf("Analyzing at most %d packages in parallel, memory limit: %d MiB", gomaxprocs, scheduler.memoryLimit/mebibyte)
 
-	var wg sync.WaitGroup
 	debugf("There are %d initial and %d total packages", len(initialPkgs), len(loadingPackages))
 	for _, lp := range loadingPackages {
 		if lp.isInitial {
-			wg.Add(1)
-			go func(lp *loadingPackage) {
-				lp.analyzeRecursive(r.loadMode, loadSem)
-				wg.Done()
-			}(lp)
+			lp.start(ctx, r.loadMode, scheduler)
 		}
 	}
-	wg.Wait()
+	for _, lp := range loadingPackages {
+		if lp.isInitial {
+			<-lp.done
+		}
+	}
+
+	scheduler.printStats()
 
 	return rootActions
 }
@@ -1,6 +1,7 @@
 package goanalysis
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"go/types"
@@ -95,7 +96,7 @@ func (act *action) waitUntilDependingAnalyzersWorked() {
 	}
 }
 
-func (act *action) analyzeSafe() {
+func (act *action) analyzeSafe(ctx context.Context) {
 	defer func() {
 		if p := recover(); p != nil {
 			if !act.isroot {
@@ -110,17 +111,28 @@ func (act *action) analyzeSafe() {
 		}
 	}()
 	act.r.sw.TrackStage(act.a.Name, func() {
-		act.analyze()
+		if !act.needAnalyzeSource {
+			act.analyze(ctx)
+			return
+		}
+
+		act.r.profiler.TrackAction(act.a.Name, act.pkg.ID, func() { act.analyze(ctx) })
 	})
 }
 
-func (act *action) analyze() {
+func (act *action) analyze(ctx context.Context) {
 	defer close(act.analysisDoneCh) // unblock actions depending on this action
 
 	if !act.needAnalyzeSource {
 		return
 	}
 
+	// Don't start the analysis after the cancellation.
+	if ctx.Err() != nil {
+		act.err = fmt.Errorf("analysis canceled: %w", ctx.Err())
+		return
+	}
+
 	defer func(now time.Time) {
 		analyzeDebugf("go/analysis: %s: %s: analyzed package %q in %s", act.r.prefix, act.a.Name, act.pkg.Name, time.Since(now))
 	}(time.Now())
@@ -1,6 +1,7 @@
 package goanalysis
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"go/ast"
@@ -16,6 +17,7 @@ import (
 	"golang.org/x/tools/go/packages"
 
 	"github.com/golangci/golangci-lint/pkg/goanalysis/load"
+	"github.com/golangci/golangci-lint/pkg/goanalysis/profile"
 	"github.com/golangci/golangci-lint/pkg/goutil"
 	"github.com/golangci/golangci-lint/pkg/logutils"
 )
@@ -29,44 +31,58 @@ type loadingPackage struct {
 	log         logutils.Log
 	actions     []*action // all actions with this package
 	loadGuard   *load.Guard
+	overlay     map[string][]byte // unsaved content of the files
+	profiler    *profile.Profiler
 	dependents  int32 // number of depending on it packages
-	analyzeOnce sync.Once
+	startOnce   sync.Once
+	done        chan struct{} // closed when the package is analyzed
 	decUseMutex sync.Mutex
 }
 
-func (lp *loadingPackage) analyzeRecursive(loadMode LoadMode, loadSem chan struct{}) {
-	lp.analyzeOnce.Do(func() {
-		// Load the direct dependencies, in parallel.
-		var wg sync.WaitGroup
-		wg.Add(len(lp.imports))
-		for _, imp := range lp.imports {
-			go func(imp *loadingPackage) {
-				imp.analyzeRecursive(loadMode, loadSem)
-				wg.Done()
-			}(imp)
-		}
-		wg.Wait()
-		lp.analyze(loadMode, loadSem)
+// start starts the analysis of the package, after the analysis of its dependencies.
+// A single goroutine is started by package: it waits for the dependencies before the analysis.
+func (lp *loadingPackage) start(ctx context.Context, loadMode LoadMode, scheduler *loadScheduler) {
+	lp.startOnce.Do(func() {
+		go func() {
+			defer close(lp.done)
+
+			// Load the direct dependencies, in parallel.
+			for _, imp := range lp.imports {
+				imp.start(ctx, loadMode, scheduler)
+			}
+
+			for _, imp := range lp.imports {
+				<-imp.done
+			}
+
+			lp.analyze(ctx, loadMode, scheduler)
+		}()
 	})
 }
 
-func (lp *loadingPackage) analyze(loadMode LoadMode, loadSem chan struct{}) {
-	loadSem <- struct{}{}
-	defer func() {
-		<-loadSem
-	}()
+func (lp *loadingPackage) analyze(ctx context.Context, loadMode LoadMode, scheduler *loadScheduler) {
+	err := scheduler.acquire(ctx)
+	if err != nil {
+		lp.failActions(fmt.Errorf("analysis of package %s canceled: %w", lp.pkg.Name, err))
+		return
+	}
+	defer scheduler.release()
 
 	// Save memory on unused more fields.
 	defer lp.decUse(loadMode < LoadModeWholeProgram)
 
-	if err := lp.loadWithFacts(loadMode); err != nil {
-		werr := fmt.Errorf("failed to load package %s: %w", lp.pkg.Name, err)
-		// Don't need to write error to errCh, it will be extracted and reported on another layer.
-		// Unblock depending on actions and propagate error.
-		for _, act := range lp.actions {
-			close(act.analysisDoneCh)
-			act.err = werr
-		}
+	// Don't load the package after the cancellation.
+	if ctx.Err() != nil {
+		lp.failActions(fmt.Errorf("analysis of package %s canceled: %w", lp.pkg.Name, ctx.Err()))
+		return
+	}
+
+	lp.profiler.TrackLoad(lp.pkg.ID, func() {
+		err = lp.loadWithFacts(loadMode)
+	})
+
+	if err != nil {
+		lp.failActions(fmt.Errorf("failed to load package %s: %w", lp.pkg.Name, err))
 		return
 	}
 
@@ -78,12 +94,22 @@ func (lp *loadingPackage) analyze(loadMode LoadMode, loadSem chan struct{}) {
 
 			act.waitUntilDependingAnalyzersWorked()
 
-			act.analyzeSafe()
+			act.analyzeSafe(ctx)
 		}(act)
 	}
 	actsWg.Wait()
 }
 
+// failActions sets the error of the actions of the package.
+func (lp *loadingPackage) failActions(err error) {
+	// Don't need to write error to errCh, it will be extracted and reported on another layer.
+	// Unblock depending on actions and propagate error.
+	for _, act := range lp.actions {
+		close(act.analysisDoneCh)
+		act.err = err
+	}
+}
+
 func (lp *loadingPackage) loadFromSource(loadMode LoadMode) error {
 	pkg := lp.pkg
 
@@ -94,7 +120,12 @@ func (lp *loadingPackage) loadFromSource(loadMode LoadMode) error {
 	// bookkeeping and potentially false sharing of cache lines.
 	pkg.Syntax = make([]*ast.File, 0, len(pkg.CompiledGoFiles))
 	for _, file := range pkg.CompiledGoFiles {
-		f, err := parser.ParseFile(pkg.Fset, file, nil, parser.ParseComments)
+		var src any
+		if content, ok := lp.overlay[file]; ok {
+			src = content
+		}
+
+		f, err := parser.ParseFile(pkg.Fset, file, src, parser.ParseComments)
 		if err != nil {
 			pkg.Errors = append(pkg.Errors, lp.convertError(err)...)
 			continue
@@ -110,6 +141,12 @@ func (lp *loadingPackage) loadFromSource(loadMode LoadMode) error {
 		return nil
 	}
 
+	// Without go/analysis linters needing types (e.g. only the linters running external commands),
+	// the imports are not loaded: the package cannot be type-checked.
+	if !importsLoaded(pkg) {
+		return nil
+	}
+
 	// Call NewPackage directly with explicit name.
 	// This avoids skew between golist and go/types when the files'
 	// package declarations are inconsistent.
@@ -507,3 +544,17 @@ func sizeOfReflectValueTreeBytes(rv reflect.Value, visitedPtrs map[uintptr]struc
 		panic("unknown rv of type " + rv.String())
 	}
 }
+
+func importsLoaded(pkg *packages.Package) bool {
+	if pkg.Imports != nil {
+		return true
+	}
+
+	for _, f := range pkg.Syntax {
+		if len(f.Imports) > 0 {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,156 @@
+package goanalysis
+
+import (
+	"context"
+	"math"
+	"runtime/debug"
+	"runtime/metrics"
+	"sync"
+	"time"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+const (
+	// memoryThrottleRatio is the part of the memory limit above which no new package is started:
+	// the rest of the memory is kept for the packages being loaded and analyzed.
+	memoryThrottleRatio = 0.75
+
+	// memoryPollInterval is the interval between 2 checks of the live heap when the scheduler is throttled:
+	// the memory can be released by the GC without the end of an analysis.
+	memoryPollInterval = 100 * time.Millisecond
+
+	liveHeapMetric = "/gc/heap/live:bytes"
+
+	mebibyte = 1 << 20
+)
+
+// loadScheduler limits the packages loaded and analyzed in parallel.
+// The CPU parallelism is limited by the number of packages analyzed in parallel (GOMAXPROCS).
+// The memory is limited by the soft memory limit of the runtime (GOMEMLIMIT, or run.memory-limit):
+// when the live heap is close to the limit, no new package is started until the memory is released.
+// At least one package is always analyzed: the analysis can't be blocked by the memory limit.
+type loadScheduler struct {
+	log logutils.Log
+
+	maxActive   int
+	memoryLimit uint64 // 0 if there is no memory limit.
+
+	liveHeap func() uint64
+
+	mu       sync.Mutex
+	active   int
+	released chan struct{} // closed when a package is released.
+
+	throttled     int
+	throttledTime time.Duration
+}
+
+func newLoadScheduler(logger logutils.Log, maxActive int) *loadScheduler {
+	s := &loadScheduler{
+		log:       logger,
+		maxActive: maxActive,
+		liveHeap:  readLiveHeap,
+		released:  make(chan struct{}),
+	}
+
+	// A negative value returns the current limit without changing it.
+	if limit := debug.SetMemoryLimit(-1); limit > 0 && limit < math.MaxInt64 {
+		s.memoryLimit = uint64(limit)
+	}
+
+	return s
+}
+
+// acquire waits until a new package can be analyzed.
+func (s *loadScheduler) acquire(ctx context.Context) error {
+	var throttledAt time.Time
+
+	for {
+		s.mu.Lock()
+
+		full := s.active >= s.maxActive
+
+		var liveHeap uint64
+		var overMemory bool
+
+		if !full && s.memoryLimit > 0 && s.active > 0 {
+			liveHeap = s.liveHeap()
+			overMemory = float64(liveHeap) >= memoryThrottleRatio*float64(s.memoryLimit)
+		}
+
+		if !full && !overMemory {
+			s.active++
+
+			if !throttledAt.IsZero() {
+				s.throttledTime += time.Since(throttledAt)
+			}
+
+			s.mu.Unlock()
+
+			return nil
+		}
+
+		if overMemory && throttledAt.IsZero() {
+			throttledAt = time.Now()
+
+			s.throttled++
+			if s.throttled == 1 {
+				s.log.Infof("Throttling the analysis: the live heap (%d MiB) is close to the memory limit (%d MiB)",
+					liveHeap/mebibyte, s.memoryLimit/mebibyte)
+			}
+		}
+
+		released := s.released
+
+		s.mu.Unlock()
+
+		var poll <-chan time.Time
+		if overMemory {
+			poll = time.After(memoryPollInterval)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-released:
+		case <-poll:
+		}
+	}
+}
+
+// release ends the analysis of a package.
+func (s *loadScheduler) release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.active--
+
+	close(s.released)
+	s.released = make(chan struct{})
+}
+
+// printStats logs the packages throttled by the memory limit.
+func (s *loadScheduler) printStats() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.throttled == 0 {
+		return
+	}
+
+	s.log.Infof("The memory limit (%d MiB) delayed the analysis of %d packages (cumulative wait: %s)",
+		s.memoryLimit/mebibyte, s.throttled, s.throttledTime.Round(time.Millisecond))
+}
+
+// readLiveHeap returns the heap marked as live by the last GC.
+func readLiveHeap() uint64 {
+	sample := []metrics.Sample{{Name: liveHeapMetric}}
+	metrics.Read(sample)
+
+	if sample[0].Value.Kind() != metrics.KindUint64 {
+		return 0
+	}
+
+	return sample[0].Value.Uint64()
+}
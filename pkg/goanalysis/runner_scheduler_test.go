@@ -0,0 +1,93 @@
+package goanalysis
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+func TestLoadScheduler_concurrency(t *testing.T) {
+	t.Parallel()
+
+	scheduler := newTestLoadScheduler(1, 0, func() uint64 { return 0 })
+
+	require.NoError(t, scheduler.acquire(context.Background()))
+
+	acquired := acquireAsync(scheduler)
+
+	assertBlocked(t, acquired)
+
+	scheduler.release()
+
+	require.NoError(t, <-acquired)
+}
+
+func TestLoadScheduler_memoryLimit(t *testing.T) {
+	t.Parallel()
+
+	var liveHeap atomic.Uint64
+	liveHeap.Store(90)
+
+	scheduler := newTestLoadScheduler(4, 100, liveHeap.Load)
+
+	// At least one package is analyzed.
+	require.NoError(t, scheduler.acquire(context.Background()))
+
+	acquired := acquireAsync(scheduler)
+
+	assertBlocked(t, acquired)
+
+	// The memory is released by the GC.
+	liveHeap.Store(10)
+
+	require.NoError(t, <-acquired)
+
+	assert.Equal(t, 1, scheduler.throttled)
+}
+
+func TestLoadScheduler_canceled(t *testing.T) {
+	t.Parallel()
+
+	scheduler := newTestLoadScheduler(1, 0, func() uint64 { return 0 })
+
+	require.NoError(t, scheduler.acquire(context.Background()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	require.ErrorIs(t, scheduler.acquire(ctx), context.Canceled)
+}
+
+func newTestLoadScheduler(maxActive int, memoryLimit uint64, liveHeap func() uint64) *loadScheduler {
+	return &loadScheduler{
+		log:         logutils.NewStderrLog(logutils.DebugKeyEmpty),
+		maxActive:   maxActive,
+		memoryLimit: memoryLimit,
+		liveHeap:    liveHeap,
+		released:    make(chan struct{}),
+	}
+}
+
+func acquireAsync(scheduler *loadScheduler) <-chan error {
+	acquired := make(chan error, 1)
+
+	go func() { acquired <- scheduler.acquire(context.Background()) }()
+
+	return acquired
+}
+
+func assertBlocked(t *testing.T, acquired <-chan error) {
+	t.Helper()
+
+	select {
+	case <-acquired:
+		t.Fatal("the package should wait")
+	case <-time.After(2 * memoryPollInterval):
+	}
+}
@@ -0,0 +1,77 @@
+package goanalysis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+func TestRunner_canceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	analyzer := &analysis.Analyzer{Name: "test"}
+
+	pkgA := &packages.Package{ID: "a", Name: "a", PkgPath: "a"}
+	pkgB := &packages.Package{ID: "b", Name: "b", PkgPath: "b"}
+
+	actA := &action{a: analyzer, pkg: pkgA, analysisDoneCh: make(chan struct{}), isroot: true, needAnalyzeSource: true}
+	actB := &action{a: analyzer, pkg: pkgB, analysisDoneCh: make(chan struct{}), isroot: true, needAnalyzeSource: true}
+
+	lp := &loadingPackage{pkg: pkgA, actions: []*action{actA}, isInitial: true, done: make(chan struct{}), dependents: 1}
+
+	lp.start(ctx, LoadModeSyntax, newLoadScheduler(logutils.NewStderrLog(logutils.DebugKeyEmpty), 1))
+	<-lp.done
+
+	require.ErrorIs(t, actA.err, context.Canceled)
+	assert.NotPanics(t, func() { <-actA.analysisDoneCh })
+
+	// The package B was analyzed before the cancellation.
+	r := &runner{}
+
+	roots := r.removeCanceledRoots([]*action{actA, actB})
+
+	assert.Equal(t, []*action{actB}, roots)
+	assert.True(t, r.isCanceled(pkgA))
+	assert.False(t, r.isCanceled(pkgB))
+}
+
+func TestAction_analyze_canceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	act := &action{
+		a:                 &analysis.Analyzer{Name: "test"},
+		pkg:               &packages.Package{ID: "a", Name: "a", PkgPath: "a"},
+		analysisDoneCh:    make(chan struct{}),
+		needAnalyzeSource: true,
+	}
+
+	act.analyze(ctx)
+
+	require.ErrorIs(t, act.err, context.Canceled)
+
+	// The actions depending on a canceled action are canceled.
+	dependent := &action{
+		a:                 act.a,
+		pkg:               act.pkg,
+		deps:              []*action{act},
+		r:                 &runner{},
+		analysisDoneCh:    make(chan struct{}),
+		needAnalyzeSource: true,
+	}
+
+	dependent.analyze(context.Background())
+
+	require.ErrorIs(t, dependent.err, context.Canceled)
+}
@@ -1,7 +1,9 @@
 package goanalysis
 
 import (
+	"context"
 	"fmt"
+	"go/token"
 	"runtime"
 	"sort"
 	"strings"
@@ -29,14 +31,16 @@ type runAnalyzersConfig interface {
 	getLoadMode() LoadMode
 }
 
-func runAnalyzers(cfg runAnalyzersConfig, lintCtx *linter.Context) ([]result.Issue, error) {
+// runAnalyzers runs the analyzers on the packages of the linter context.
+// When the context is canceled, only the issues of the packages analyzed before the cancellation are returned.
+func runAnalyzers(ctx context.Context, cfg runAnalyzersConfig, lintCtx *linter.Context) ([]result.Issue, error) {
 	log := lintCtx.Log.Child(logutils.DebugKeyGoAnalysis)
 	sw := timeutils.NewStopwatch("analyzers", log)
 
 	const stagesToPrint = 10
 	defer sw.PrintTopStages(stagesToPrint)
 
-	runner := newRunner(cfg.getName(), log, lintCtx.PkgCache, lintCtx.LoadGuard, cfg.getLoadMode(), sw)
+	runner := newRunner(cfg.getName(), log, lintCtx.PkgCache, lintCtx.LoadGuard, lintCtx.Overlay, lintCtx.Profiler, cfg.getLoadMode(), sw)
 
 	pkgs := lintCtx.Packages
 	if cfg.useOriginalPackages() {
@@ -51,10 +55,11 @@ func runAnalyzers(cfg runAnalyzersConfig, lintCtx *linter.Context) ([]result.Iss
 		}
 	}
 
-	diags, errs, passToPkg := runner.run(cfg.getAnalyzers(), pkgsToAnalyze)
+	diags, errs, passToPkg := runner.run(ctx, cfg.getAnalyzers(), pkgsToAnalyze)
 
 	defer func() {
-		if len(errs) == 0 {
+		// The issues of the packages analyzed before the cancellation are incomplete.
+		if len(errs) == 0 && ctx.Err() == nil {
 			// If we try to save to cache even if we have compilation errors
 			// we won't see them on repeated runs.
 			saveIssuesToCache(pkgs, pkgsFromCache, issues, lintCtx, cfg.getAnalyzers())
@@ -69,6 +74,9 @@ func runAnalyzers(cfg runAnalyzersConfig, lintCtx *linter.Context) ([]result.Iss
 			if issue.Pkg == nil {
 				issue.Pkg = passToPkg[reportedIssues[i].Pass]
 			}
+			if runner.isCanceled(issue.Pkg) {
+				continue
+			}
 			retIssues = append(retIssues, *issue)
 		}
 		retIssues = append(retIssues, buildIssues(diags, cfg.getLinterNameForDiagnostic)...)
@@ -92,18 +100,23 @@ func buildIssues(diags []Diagnostic, linterNameBuilder func(diag *Diagnostic) st
 		diag := &diags[i]
 		linterName := linterNameBuilder(diag)
 
-		var text string
+		// The analyzers of the metalinters are the rules of the linter (ex: `govet/printf`, `staticcheck/SA4006`).
+		var text, ruleID string
 		if diag.Analyzer.Name == linterName {
 			text = diag.Message
 		} else {
 			text = fmt.Sprintf("%s: %s", diag.Analyzer.Name, diag.Message)
+			ruleID = diag.Analyzer.Name
 		}
 
 		issues = append(issues, result.Issue{
-			FromLinter: linterName,
-			Text:       text,
-			Pos:        diag.Position,
-			Pkg:        diag.Pkg,
+			FromLinter:     linterName,
+			Text:           text,
+			RuleID:         ruleID,
+			Pos:            diag.Position,
+			End:            buildEnd(diag.Pkg.Fset, diag.Pos, diag.End),
+			Pkg:            diag.Pkg,
+			SuggestedFixes: buildSuggestedFixes(diag.Pkg.Fset, diag.SuggestedFixes),
 		})
 
 		if len(diag.Related) > 0 {
@@ -111,7 +124,9 @@ func buildIssues(diags []Diagnostic, linterNameBuilder func(diag *Diagnostic) st
 				issues = append(issues, result.Issue{
 					FromLinter: linterName,
 					Text:       fmt.Sprintf("%s(related information): %s", diag.Analyzer.Name, info.Message),
+					RuleID:     ruleID,
 					Pos:        diag.Pkg.Fset.Position(info.Pos),
+					End:        buildEnd(diag.Pkg.Fset, info.Pos, info.End),
 					Pkg:        diag.Pkg,
 				})
 			}
@@ -120,6 +135,78 @@ func buildIssues(diags []Diagnostic, linterNameBuilder func(diag *Diagnostic) st
 	return issues
 }
 
+// buildEnd returns the end of a diagnostic, if it's set and in the file of its position.
+func buildEnd(fset *token.FileSet, pos, end token.Pos) *result.Position {
+	if !end.IsValid() || end <= pos {
+		return nil
+	}
+
+	start := fset.Position(pos)
+	position := fset.Position(end)
+
+	if position.Filename != start.Filename {
+		return nil
+	}
+
+	return &result.Position{Offset: position.Offset, Line: position.Line, Column: position.Column}
+}
+
+// buildSuggestedFixes converts the fixes of a diagnostic into byte-offset based edits.
+// A fix with an edit that cannot be resolved is dropped:
+// it's better to not fix an issue than to corrupt a file.
+func buildSuggestedFixes(fset *token.FileSet, fixes []analysis.SuggestedFix) []result.SuggestedFix {
+	var suggestedFixes []result.SuggestedFix
+
+	for _, fix := range fixes {
+		if len(fix.TextEdits) == 0 {
+			continue
+		}
+
+		textEdits, ok := buildTextEdits(fset, fix.TextEdits)
+		if !ok {
+			continue
+		}
+
+		suggestedFixes = append(suggestedFixes, result.SuggestedFix{
+			Message:   fix.Message,
+			TextEdits: textEdits,
+		})
+	}
+
+	return suggestedFixes
+}
+
+func buildTextEdits(fset *token.FileSet, edits []analysis.TextEdit) ([]result.TextEdit, bool) {
+	textEdits := make([]result.TextEdit, 0, len(edits))
+
+	for _, edit := range edits {
+		end := edit.End
+		if !end.IsValid() {
+			// An edit without end is an insertion.
+			end = edit.Pos
+		}
+
+		file := fset.File(edit.Pos)
+		if file == nil || end < edit.Pos || end > token.Pos(file.Base()+file.Size()) {
+			return nil, false
+		}
+
+		// Cgo files are preprocessed files from the Go cache: editing them is meaningless.
+		if !strings.HasSuffix(file.Name(), ".go") {
+			return nil, false
+		}
+
+		textEdits = append(textEdits, result.TextEdit{
+			Filename: file.Name(),
+			Start:    file.Offset(edit.Pos),
+			End:      file.Offset(end),
+			NewText:  string(edit.NewText),
+		})
+	}
+
+	return textEdits, true
+}
+
 func getIssuesCacheKey(analyzers []*analysis.Analyzer) string {
 	return "lint/result:" + analyzersHashID(analyzers)
 }
@@ -153,10 +240,13 @@ func saveIssuesToCache(allPkgs []*packages.Package, pkgsFromCache map[*packages.
 					encodedIssues = append(encodedIssues, EncodingIssue{
 						FromLinter:           i.FromLinter,
 						Text:                 i.Text,
+						RuleID:               i.RuleID,
 						Severity:             i.Severity,
 						Pos:                  i.Pos,
+						End:                  i.End,
 						LineRange:            i.LineRange,
 						Replacement:          i.Replacement,
+						SuggestedFixes:       i.SuggestedFixes,
 						ExpectNoLint:         i.ExpectNoLint,
 						ExpectedNoLintLinter: i.ExpectedNoLintLinter,
 					})
@@ -226,10 +316,13 @@ func loadIssuesFromCache(pkgs []*packages.Package, lintCtx *linter.Context,
 					issues = append(issues, result.Issue{
 						FromLinter:           issue.FromLinter,
 						Text:                 issue.Text,
+						RuleID:               issue.RuleID,
 						Severity:             issue.Severity,
 						Pos:                  issue.Pos,
+						End:                  issue.End,
 						LineRange:            issue.LineRange,
 						Replacement:          issue.Replacement,
+						SuggestedFixes:       issue.SuggestedFixes,
 						Pkg:                  pkg,
 						ExpectNoLint:         issue.ExpectNoLint,
 						ExpectedNoLintLinter: issue.ExpectedNoLintLinter,
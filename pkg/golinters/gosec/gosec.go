@@ -130,6 +130,12 @@ func runGoSec(lintCtx *linter.Context, pass *analysis.Pass, settings *config.GoS
 			continue
 		}
 
+		// gosec only provides the lines of the multi-line issues.
+		var end *result.Position
+		if r != nil {
+			end = &result.Position{Line: r.To}
+		}
+
 		issues = append(issues, goanalysis.NewIssue(&result.Issue{
 			Severity: convertScoreToString(i.Severity),
 			Pos: token.Position{
@@ -137,7 +143,9 @@ func runGoSec(lintCtx *linter.Context, pass *analysis.Pass, settings *config.GoS
 				Line:     line,
 				Column:   column,
 			},
+			End:        end,
 			Text:       text,
+			RuleID:     i.RuleID,
 			LineRange:  r,
 			FromLinter: linterName,
 		}, pass))
@@ -128,10 +128,11 @@ const (
 	NeedsAll = NeedsMachineOnly | NeedsSpecific | NeedsExplanation
 )
 
-var commentPattern = regexp.MustCompile(`^//\s*(nolint)(:\s*[\w-]+\s*(?:,\s*[\w-]+\s*)*)?\b`)
+// The linters can be followed by a rule: `linter/rule`.
+var commentPattern = regexp.MustCompile(`^//\s*(nolint)(:\s*[\w-]+(?:/[\w-]+)?\s*(?:,\s*[\w-]+(?:/[\w-]+)?\s*)*)?\b`)
 
 // matches a complete nolint directive
-var fullDirectivePattern = regexp.MustCompile(`^//\s*nolint(?::(\s*[\w-]+\s*(?:,\s*[\w-]+\s*)*))?\s*(//.*)?\s*\n?$`)
+var fullDirectivePattern = regexp.MustCompile(`^//\s*nolint(?::(\s*[\w-]+(?:/[\w-]+)?\s*(?:,\s*[\w-]+(?:/[\w-]+)?\s*)*))?\s*(//.*)?\s*\n?$`)
 
 type Linter struct {
 	needs           Needs // indicates which linter checks to perform
@@ -289,7 +290,9 @@ func (l Linter) Run(fset *token.FileSet, nodes ...ast.Node) ([]Issue, error) {
 					needsExplanation := len(linters) == 0 // if no linters are mentioned, we must have explanation
 					// otherwise, check if we are excluding all the mentioned linters
 					for _, ll := range linters {
-						if !l.excludeByLinter[ll] { // if a linter does require explanation
+						linterName, _, _ := strings.Cut(ll, "/")
+
+						if !l.excludeByLinter[ll] && !l.excludeByLinter[linterName] { // if a linter does require explanation
 							needsExplanation = true
 							break
 						}
@@ -134,6 +134,20 @@ func foo() {
 				{issue: "directive `//nolint:linter1 linter2` should match `//nolint[:<comma-separated-linters>] [// <explanation>]` at testing.go:6:9"},
 			},
 		},
+		{
+			desc: "rules of the linters are allowed",
+			contents: `
+package bar
+
+func foo() {
+  good() //nolint:staticcheck/SA4006
+  good() //nolint:staticcheck/SA4006, gosec/G104,revive
+  bad() //nolint:staticcheck/SA4006/SA4007
+}`,
+			expected: []issueWithReplacement{
+				{issue: "directive `//nolint:staticcheck/SA4006/SA4007` should match `//nolint[:<comma-separated-linters>] [// <explanation>]` at testing.go:7:9"},
+			},
+		},
 		{
 			desc: "multi-line comments don't confuse parser",
 			contents: `
@@ -207,6 +221,25 @@ func foo() {
 				},
 			},
 		},
+		{
+			desc:  "needs unused with a rule of a linter",
+			needs: NeedsUnused,
+			contents: `
+package bar
+
+//nolint:staticcheck/SA4006
+func foo() {
+  bad()
+}`,
+			expected: []issueWithReplacement{
+				{
+					issue: "directive `//nolint:staticcheck/SA4006` is unused for linter \"staticcheck/SA4006\" at testing.go:4:1",
+					replacement: &result.Replacement{
+						NeedOnlyDelete: true,
+					},
+				},
+			},
+		},
 		{
 			desc:  "needs unused with multiple specific linters does not generate replacements",
 			needs: NeedsUnused,
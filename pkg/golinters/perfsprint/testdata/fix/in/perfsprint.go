@@ -0,0 +1,16 @@
+//golangcitest:args -Eperfsprint
+//golangcitest:expected_exitcode 0
+package testdata
+
+import (
+	"fmt"
+)
+
+func TestPerfsprint() {
+	var s string
+
+	_ = fmt.Sprintf("%s", s)
+	_ = fmt.Sprint(s)
+	_ = fmt.Sprintf("test")
+	_ = fmt.Sprintf("%s", fmt.Sprint(s))
+}
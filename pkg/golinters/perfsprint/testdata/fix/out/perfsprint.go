@@ -0,0 +1,16 @@
+//golangcitest:args -Eperfsprint
+//golangcitest:expected_exitcode 0
+package testdata
+
+import (
+	"fmt"
+)
+
+func TestPerfsprint() {
+	var s string
+
+	_ = s
+	_ = s
+	_ = "test"
+	_ = fmt.Sprint(s)
+}
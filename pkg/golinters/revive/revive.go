@@ -160,19 +160,25 @@ func (w *wrapper) run(lintCtx *linter.Context, pass *analysis.Pass) ([]goanalysi
 
 func toIssue(pass *analysis.Pass, object *jsonObject) goanalysis.Issue {
 	lineRangeTo := object.Position.End.Line
+	end := toEnd(&object.Position)
+
 	if object.RuleName == (&rule.ExportedRule{}).Name() {
+		// The failure covers the whole declaration: only its first line is reported.
 		lineRangeTo = object.Position.Start.Line
+		end = nil
 	}
 
 	return goanalysis.NewIssue(&result.Issue{
 		Severity: string(object.Severity),
 		Text:     fmt.Sprintf("%s: %s", object.RuleName, object.Failure.Failure),
+		RuleID:   object.RuleName,
 		Pos: token.Position{
 			Filename: object.Position.Start.Filename,
 			Line:     object.Position.Start.Line,
 			Offset:   object.Position.Start.Offset,
 			Column:   object.Position.Start.Column,
 		},
+		End: end,
 		LineRange: &result.Range{
 			From: object.Position.Start.Line,
 			To:   lineRangeTo,
@@ -181,6 +187,18 @@ func toIssue(pass *analysis.Pass, object *jsonObject) goanalysis.Issue {
 	}, pass)
 }
 
+func toEnd(position *lint.FailurePosition) *result.Position {
+	if position.End.Line == 0 || position.End.Offset <= position.Start.Offset {
+		return nil
+	}
+
+	return &result.Position{
+		Offset: position.End.Offset,
+		Line:   position.End.Line,
+		Column: position.End.Column,
+	}
+}
+
 // This function mimics the GetConfig function of revive.
 // This allows to get default values and right types.
 // https://github.com/golangci/golangci-lint/issues/1745
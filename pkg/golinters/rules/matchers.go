@@ -0,0 +1,183 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/types"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+)
+
+// reportFunc reports a node matched by a rule, with the default message of the matcher.
+type reportFunc func(node ast.Node, message string)
+
+// matchFunc reports the nodes of a file matched by a rule.
+type matchFunc func(pass *analysis.Pass, file *ast.File, report reportFunc)
+
+func newMatcher(settings *config.RuleSettings) (matchFunc, error) {
+	switch {
+	case settings.Call != "":
+		return matchCall(settings.Call), nil
+
+	case settings.Type != "":
+		return matchType(settings.Type), nil
+
+	case settings.Literal != "":
+		return matchLiteral(settings.Literal), nil
+
+	case settings.Import != "":
+		return matchImport(settings.Import), nil
+
+	case settings.Identifier != "":
+		re, err := regexp.Compile(settings.Identifier)
+		if err != nil {
+			return nil, fmt.Errorf("invalid identifier: %w", err)
+		}
+
+		return matchIdentifier(re), nil
+
+	case settings.FirstParam != "":
+		return matchFirstParam(settings.FirstParam), nil
+
+	default:
+		return nil, errors.New("no matcher")
+	}
+}
+
+// matchCall matches the calls of a function or a method (ex: `time.Now`, `(*net/http.Client).Do`).
+func matchCall(name string) matchFunc {
+	return func(pass *analysis.Pass, file *ast.File, report reportFunc) {
+		ast.Inspect(file, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+
+			fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+			if ok && fn.Origin().FullName() == name {
+				report(call.Fun, fmt.Sprintf("call of `%s` is forbidden", name))
+			}
+
+			return true
+		})
+	}
+}
+
+// matchType matches the uses of a type (ex: `net/http.Client`).
+func matchType(name string) matchFunc {
+	return func(pass *analysis.Pass, file *ast.File, report reportFunc) {
+		ast.Inspect(file, func(node ast.Node) bool {
+			ident, ok := node.(*ast.Ident)
+			if !ok {
+				return true
+			}
+
+			obj, ok := pass.TypesInfo.Uses[ident].(*types.TypeName)
+			if ok && qualifiedName(obj) == name {
+				report(ident, fmt.Sprintf("use of the type `%s` is forbidden", name))
+			}
+
+			return true
+		})
+	}
+}
+
+// matchLiteral matches the composite literals of a type outside of the package of the type.
+func matchLiteral(name string) matchFunc {
+	return func(pass *analysis.Pass, file *ast.File, report reportFunc) {
+		ast.Inspect(file, func(node ast.Node) bool {
+			lit, ok := node.(*ast.CompositeLit)
+			if !ok {
+				return true
+			}
+
+			named, ok := types.Unalias(pass.TypesInfo.TypeOf(lit)).(*types.Named)
+			if !ok {
+				return true
+			}
+
+			obj := named.Origin().Obj()
+
+			if obj.Pkg() != nil && qualifiedName(obj) == name && obj.Pkg().Path() != pass.Pkg.Path() {
+				report(lit, fmt.Sprintf("literal of the type `%s` outside of its package is forbidden", name))
+			}
+
+			return true
+		})
+	}
+}
+
+// matchImport matches the imports of a package, or of the packages under a path (ex: `example.com/internal/...`).
+func matchImport(pattern string) matchFunc {
+	prefix, isPrefix := strings.CutSuffix(pattern, "/...")
+
+	return func(_ *analysis.Pass, file *ast.File, report reportFunc) {
+		for _, spec := range file.Imports {
+			path, err := strconv.Unquote(spec.Path.Value)
+			if err != nil {
+				continue
+			}
+
+			if path == pattern || isPrefix && (path == prefix || strings.HasPrefix(path, prefix+"/")) {
+				report(spec, fmt.Sprintf("import of `%s` is forbidden", path))
+			}
+		}
+	}
+}
+
+// matchIdentifier matches the declared identifiers.
+func matchIdentifier(re *regexp.Regexp) matchFunc {
+	return func(pass *analysis.Pass, file *ast.File, report reportFunc) {
+		ast.Inspect(file, func(node ast.Node) bool {
+			ident, ok := node.(*ast.Ident)
+			if !ok || ident.Name == "_" {
+				return true
+			}
+
+			if pass.TypesInfo.Defs[ident] != nil && re.MatchString(ident.Name) {
+				report(ident, fmt.Sprintf("identifier `%s` is forbidden", ident.Name))
+			}
+
+			return true
+		})
+	}
+}
+
+// matchFirstParam matches the exported functions and methods without a first parameter of a type (ex: `context.Context`).
+func matchFirstParam(name string) matchFunc {
+	return func(pass *analysis.Pass, file *ast.File, report reportFunc) {
+		for _, decl := range file.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || !fd.Name.IsExported() {
+				continue
+			}
+
+			fn, ok := pass.TypesInfo.Defs[fd.Name].(*types.Func)
+			if !ok {
+				continue
+			}
+
+			params := fn.Type().(*types.Signature).Params()
+			if params.Len() > 0 && types.TypeString(params.At(0).Type(), nil) == name {
+				continue
+			}
+
+			report(fd.Name, fmt.Sprintf("the first parameter of `%s` must be of type `%s`", fd.Name.Name, name))
+		}
+	}
+}
+
+func qualifiedName(obj types.Object) string {
+	if obj.Pkg() == nil {
+		return obj.Name()
+	}
+
+	return obj.Pkg().Path() + "." + obj.Name()
+}
@@ -0,0 +1,209 @@
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/gobwas/glob"
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/goanalysis"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+const linterName = "rules"
+
+func New(settings *config.RulesSettings) *goanalysis.Linter {
+	var mu sync.Mutex
+	var resIssues []goanalysis.Issue
+
+	// The errors of the settings are reported by the analyzer.
+	rs, errRules := newRuleSet(settings)
+
+	analyzer := &analysis.Analyzer{
+		Name: linterName,
+		Doc:  goanalysis.TheOnlyanalyzerDoc,
+		Run: func(pass *analysis.Pass) (any, error) {
+			if errRules != nil {
+				return nil, errRules
+			}
+
+			issues := rs.run(pass)
+
+			if len(issues) == 0 {
+				return nil, nil
+			}
+
+			mu.Lock()
+			resIssues = append(resIssues, issues...)
+			mu.Unlock()
+
+			return nil, nil
+		},
+	}
+
+	return goanalysis.NewLinter(
+		linterName,
+		"Reports the code matching the custom rules of the configuration",
+		[]*analysis.Analyzer{analyzer},
+		nil,
+	).WithIssuesReporter(func(*linter.Context) []goanalysis.Issue {
+		return resIssues
+	}).WithLoadMode(goanalysis.LoadModeTypesInfo)
+}
+
+// ruleSet is the set of the rules of the configuration.
+type ruleSet struct {
+	// baseDir is the directory of the relative paths of the files matched by the rules.
+	baseDir string
+
+	rules []*rule
+}
+
+func newRuleSet(settings *config.RulesSettings) (*ruleSet, error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+
+	rs := &ruleSet{baseDir: wd}
+
+	for i := range settings.Rules {
+		r, err := newRule(&settings.Rules[i])
+		if err != nil {
+			return nil, fmt.Errorf("rule %q: %w", settings.Rules[i].Name, err)
+		}
+
+		rs.rules = append(rs.rules, r)
+	}
+
+	return rs, nil
+}
+
+func (rs *ruleSet) run(pass *analysis.Pass) []goanalysis.Issue {
+	var issues []goanalysis.Issue
+
+	for _, r := range rs.rules {
+		if !matchAny(r.packages, pass.Pkg.Path()) {
+			continue
+		}
+
+		for _, file := range pass.Files {
+			if !matchAny(r.paths, rs.relativePath(pass.Fset.Position(file.Pos()).Filename)) {
+				continue
+			}
+
+			r.match(pass, file, func(node ast.Node, message string) {
+				issues = append(issues, r.newIssue(pass, node, message))
+			})
+		}
+	}
+
+	return issues
+}
+
+func (rs *ruleSet) relativePath(filename string) string {
+	rel, err := filepath.Rel(rs.baseDir, filename)
+	if err != nil {
+		return filepath.ToSlash(filename)
+	}
+
+	return filepath.ToSlash(rel)
+}
+
+type rule struct {
+	name    string
+	message string
+
+	packages []glob.Glob
+	paths    []glob.Glob
+
+	match matchFunc
+}
+
+func newRule(settings *config.RuleSettings) (*rule, error) {
+	packages, err := compileGlobs(settings.Packages)
+	if err != nil {
+		return nil, fmt.Errorf("packages: %w", err)
+	}
+
+	paths, err := compileGlobs(settings.Paths)
+	if err != nil {
+		return nil, fmt.Errorf("paths: %w", err)
+	}
+
+	match, err := newMatcher(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rule{
+		name:     settings.Name,
+		message:  settings.Message,
+		packages: packages,
+		paths:    paths,
+		match:    match,
+	}, nil
+}
+
+// newIssue creates an issue of the rule: the message of the configuration replaces the default message of the matcher.
+func (r *rule) newIssue(pass *analysis.Pass, node ast.Node, message string) goanalysis.Issue {
+	if r.message != "" {
+		message = r.message
+	}
+
+	end := pass.Fset.Position(node.End())
+
+	return goanalysis.NewIssue(&result.Issue{
+		FromLinter: linterName,
+		Text:       fmt.Sprintf("%s: %s", r.name, message),
+		RuleID:     r.name,
+		Pos:        pass.Fset.Position(node.Pos()),
+		End:        &result.Position{Offset: end.Offset, Line: end.Line, Column: end.Column},
+	}, pass)
+}
+
+// compileGlobs compiles the patterns: a pattern ending with `/**` also matches its prefix
+// (e.g. `internal/domain/**` matches the package `internal/domain` and its sub-packages).
+func compileGlobs(patterns []string) ([]glob.Glob, error) {
+	var globs []glob.Glob
+
+	for _, pattern := range patterns {
+		alternatives := []string{pattern}
+		if prefix, ok := strings.CutSuffix(pattern, "/**"); ok && prefix != "" {
+			alternatives = append(alternatives, prefix)
+		}
+
+		for _, alternative := range alternatives {
+			g, err := glob.Compile(alternative, '/')
+			if err != nil {
+				return nil, fmt.Errorf("invalid glob %q: %w", pattern, err)
+			}
+
+			globs = append(globs, g)
+		}
+	}
+
+	return globs, nil
+}
+
+// matchAny returns true if one of the globs matches the value, or if there are no globs.
+func matchAny(globs []glob.Glob, value string) bool {
+	if len(globs) == 0 {
+		return true
+	}
+
+	for _, g := range globs {
+		if g.Match(value) {
+			return true
+		}
+	}
+
+	return false
+}
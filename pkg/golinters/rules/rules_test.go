@@ -0,0 +1,67 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_compileGlobs(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		patterns []string
+		value    string
+		expected bool
+	}{
+		{
+			desc:     "no patterns",
+			value:    "example.com/internal/domain",
+			expected: true,
+		},
+		{
+			desc:     "sub-package",
+			patterns: []string{"example.com/internal/domain/**"},
+			value:    "example.com/internal/domain/user",
+			expected: true,
+		},
+		{
+			desc:     "prefix package",
+			patterns: []string{"example.com/internal/domain/**"},
+			value:    "example.com/internal/domain",
+			expected: true,
+		},
+		{
+			desc:     "other package with the same prefix",
+			patterns: []string{"example.com/internal/domain/**"},
+			value:    "example.com/internal/domainx",
+		},
+		{
+			desc:     "single level",
+			patterns: []string{"example.com/internal/*"},
+			value:    "example.com/internal/domain/user",
+		},
+		{
+			desc:     "exact package",
+			patterns: []string{"example.com/internal/domain"},
+			value:    "example.com/internal/domain",
+			expected: true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			globs, err := compileGlobs(test.patterns)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, matchAny(globs, test.value))
+		})
+	}
+}
+
+func Test_compileGlobs_error(t *testing.T) {
+	_, err := compileGlobs([]string{"example.com/[domain/**"})
+	require.ErrorContains(t, err, `invalid glob "example.com/[domain/**"`)
+}
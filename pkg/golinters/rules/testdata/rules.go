@@ -0,0 +1,40 @@
+//golangcitest:args -Erules
+//golangcitest:config_path testdata/rules.yml
+package testdata
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+	"unsafe" // want "no-unsafe: import of `unsafe` is forbidden"
+)
+
+func Now() time.Time {
+	return time.Now() // want "no-time-now: use the clock of the service"
+}
+
+func Sleep() {
+	time.Sleep(time.Second) // want "no-sleep: call of `time.Sleep` is forbidden"
+}
+
+func After() <-chan time.Time {
+	return time.After(time.Second) // Not reported: the rule only applies to other packages.
+}
+
+func Do(client *http.Client, req *http.Request) (*http.Response, error) {
+	return client.Do(req) // want `no-client-do: call of \x60\(\*net/http.Client\).Do\x60 is forbidden`
+}
+
+func Parse(raw string) (*url.URL, error) { // want "no-url-type: use of the type `net/url.URL` is forbidden"
+	return url.Parse(raw)
+}
+
+func Values() url.Values {
+	return url.Values{"a": {"b"}} // want "no-values-literal: literal of the type `net/url.Values` outside of its package is forbidden"
+}
+
+func Size() uintptr {
+	tmpValue := 1 // want "no-tmp: identifier `tmpValue` is forbidden"
+
+	return unsafe.Sizeof(tmpValue)
+}
@@ -0,0 +1,27 @@
+//golangcitest:args -Erules
+//golangcitest:config_path testdata/rules.yml
+package testdata
+
+import "context"
+
+func Find(ctx context.Context, id string) error {
+	return nil
+}
+
+func Create(id string) error { // want "ctx-first: the first parameter of `Create` must be of type `context.Context`"
+	return nil
+}
+
+func (s *service) Delete(ctx context.Context, id string) error {
+	return nil
+}
+
+func (s *service) Update(id string) error { // want "ctx-first: the first parameter of `Update` must be of type `context.Context`"
+	return nil
+}
+
+func (s *service) validate(id string) error {
+	return nil
+}
+
+type service struct{}
@@ -0,0 +1,236 @@
+package lint
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/goanalysis"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+)
+
+// ErrNoChangedPackages is returned when no analyzed package changed since the revision of `run.changed-since`.
+var ErrNoChangedPackages = errors.New("no package changed")
+
+// moduleFiles are the files changing the build of all the packages.
+var moduleFiles = []string{"go.mod", "go.sum", "go.work", "go.work.sum"}
+
+// changedPackagesArgs returns the patterns of the packages changed since the revision of `run.changed-since`.
+// The packages depending on the changed packages are added when the results of a package depend on its dependencies.
+func (l *PackageLoader) changedPackagesArgs(ctx context.Context, args []string, withDependents bool) ([]string, error) {
+	rev := l.cfg.Run.ChangedSince
+
+	files, err := changedFiles(ctx, rev)
+	if err != nil {
+		return nil, fmt.Errorf("can't get the files changed since %s: %w", rev, err)
+	}
+
+	if slices.ContainsFunc(files, func(file string) bool { return slices.Contains(moduleFiles, filepath.Base(file)) }) {
+		l.log.Infof("The module changed since %s: all the packages are analyzed", rev)
+		return args, nil
+	}
+
+	// Only the files and the imports are needed to find the changed packages and their dependents.
+	conf := &packages.Config{
+		Mode:       packages.NeedName | packages.NeedFiles | packages.NeedImports | packages.NeedEmbedFiles,
+		Dir:        l.dir,
+		Tests:      l.cfg.Run.AnalyzeTests,
+		Context:    ctx,
+		BuildFlags: l.makeBuildFlags(),
+		Logf:       l.debugf,
+		Overlay:    l.overlay,
+	}
+
+	pkgs, err := packages.Load(conf, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the packages: %w", err)
+	}
+
+	pkgs = l.filterTestMainPackages(pkgs)
+
+	changed := findChangedPackages(pkgs, files)
+
+	l.log.Infof("%d packages changed since %s", len(changed), rev)
+
+	if len(changed) == 0 {
+		return nil, ErrNoChangedPackages
+	}
+
+	if withDependents {
+		changed = addDependents(pkgs, changed)
+	}
+
+	var patterns []string
+
+	for _, pkg := range pkgs {
+		if !changed[pkg.ID] {
+			continue
+		}
+
+		// The test variants are loaded with the tested package.
+		pattern := pkg.PkgPath
+		if matches := l.pkgTestIDRe.FindStringSubmatch(pkg.ID); matches != nil {
+			pattern = matches[2]
+		}
+
+		if !slices.Contains(patterns, pattern) {
+			patterns = append(patterns, pattern)
+		}
+	}
+
+	slices.Sort(patterns)
+
+	l.log.Infof("Analyzing %d packages (with the dependents: %t)", len(patterns), withDependents)
+
+	return patterns, nil
+}
+
+// findChangedPackages returns the IDs of the packages containing a changed file.
+// The Go files are matched by directory: the deleted files, and the files excluded by build constraints, change the package too.
+func findChangedPackages(pkgs []*packages.Package, files []string) map[string]bool {
+	dirs := map[string]bool{}
+
+	for _, file := range files {
+		if filepath.Ext(file) == ".go" {
+			dirs[filepath.Dir(file)] = true
+		}
+	}
+
+	changed := map[string]bool{}
+
+	for _, pkg := range pkgs {
+		goFiles := slices.Concat(pkg.GoFiles, pkg.IgnoredFiles)
+
+		if len(goFiles) > 0 && dirs[filepath.Dir(goFiles[0])] {
+			changed[pkg.ID] = true
+			continue
+		}
+
+		for _, file := range slices.Concat(pkg.OtherFiles, pkg.EmbedFiles) {
+			if slices.Contains(files, file) {
+				changed[pkg.ID] = true
+				break
+			}
+		}
+	}
+
+	return changed
+}
+
+// addDependents adds the packages importing, directly or not, the changed packages.
+func addDependents(pkgs []*packages.Package, changed map[string]bool) map[string]bool {
+	importers := map[string][]string{}
+
+	for _, pkg := range pkgs {
+		for _, imp := range pkg.Imports {
+			importers[imp.ID] = append(importers[imp.ID], pkg.ID)
+		}
+	}
+
+	result := map[string]bool{}
+
+	var queue []string
+
+	for id := range changed {
+		result[id] = true
+		queue = append(queue, id)
+	}
+
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		for _, importer := range importers[id] {
+			if result[importer] {
+				continue
+			}
+
+			result[importer] = true
+			queue = append(queue, importer)
+		}
+	}
+
+	return result
+}
+
+// needDependents returns true if the issues of a package depend on the analysis of its dependencies for one of the linters.
+func needDependents(linters []*linter.Config) bool {
+	for _, lc := range linters {
+		// The linters without types only analyze the files of the package.
+		if lc.LoadMode&packages.NeedDeps == 0 {
+			continue
+		}
+
+		if fu, ok := lc.Linter.(factsUser); !ok || fu.UsesFacts() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// factsUser is implemented by the go/analysis linters.
+type factsUser interface {
+	UsesFacts() bool
+}
+
+var (
+	_ factsUser = (*goanalysis.Linter)(nil)
+	_ factsUser = (*goanalysis.MetaLinter)(nil)
+)
+
+// changedFiles returns the absolute paths of the files changed since a revision, including the untracked files.
+func changedFiles(ctx context.Context, rev string) ([]string, error) {
+	changed, err := gitFiles(ctx, "diff", "--name-only", "--no-renames", "--relative", "-z", rev, "--")
+	if err != nil {
+		return nil, err
+	}
+
+	untracked, err := gitFiles(ctx, "ls-files", "--others", "--exclude-standard", "-z")
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+
+	for _, file := range slices.Concat(changed, untracked) {
+		abs, err := filepath.Abs(filepath.FromSlash(file))
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, abs)
+	}
+
+	return files, nil
+}
+
+// gitFiles returns the paths printed by a git command (relative to the working directory).
+func gitFiles(ctx context.Context, args ...string) ([]string, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+
+	stderr := new(bytes.Buffer)
+	cmd.Stderr = stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", strings.Join(cmd.Args, " "), err, strings.TrimSpace(stderr.String()))
+	}
+
+	var files []string
+
+	for _, file := range strings.Split(string(out), "\x00") {
+		if file != "" {
+			files = append(files, file)
+		}
+	}
+
+	return files, nil
+}
@@ -0,0 +1,192 @@
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/goanalysis"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+)
+
+type testFact struct{}
+
+func (*testFact) AFact() {}
+
+func Test_findChangedPackages(t *testing.T) {
+	pkgs := testChangedPackages(t)
+
+	testCases := []struct {
+		desc     string
+		files    []string
+		expected []string
+	}{
+		{
+			desc:     "go file",
+			files:    []string{"a/a.go"},
+			expected: []string{"example.com/a", "example.com/a [example.com/a.test]", "example.com/a_test [example.com/a.test]"},
+		},
+		{
+			desc:     "deleted go file",
+			files:    []string{"b/deleted.go"},
+			expected: []string{"example.com/b"},
+		},
+		{
+			desc:     "embedded file",
+			files:    []string{"b/data.txt"},
+			expected: []string{"example.com/b"},
+		},
+		{
+			desc:  "other file",
+			files: []string{"b/README.md"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			var files []string
+			for _, file := range test.files {
+				files = append(files, testChangedPath(t, file))
+			}
+
+			changed := findChangedPackages(pkgs, files)
+
+			assert.ElementsMatch(t, test.expected, changedIDs(changed))
+		})
+	}
+}
+
+func Test_addDependents(t *testing.T) {
+	pkgs := testChangedPackages(t)
+
+	changed := addDependents(pkgs, map[string]bool{"example.com/a": true})
+
+	expected := []string{"example.com/a", "example.com/b", "example.com/c"}
+
+	assert.ElementsMatch(t, expected, changedIDs(changed))
+}
+
+func Test_needDependents(t *testing.T) {
+	withFacts := &analysis.Analyzer{Name: "facts", FactTypes: []analysis.Fact{new(testFact)}}
+	withoutFacts := &analysis.Analyzer{Name: "nofacts"}
+	requiringFacts := &analysis.Analyzer{Name: "requires", Requires: []*analysis.Analyzer{withFacts}}
+
+	testCases := []struct {
+		desc     string
+		linters  []*linter.Config
+		expected bool
+	}{
+		{
+			desc: "without facts",
+			linters: []*linter.Config{
+				linter.NewConfig(goanalysis.NewLinter("a", "", []*analysis.Analyzer{withoutFacts}, nil)).WithLoadForGoAnalysis(),
+			},
+		},
+		{
+			desc: "facts",
+			linters: []*linter.Config{
+				linter.NewConfig(goanalysis.NewLinter("a", "", []*analysis.Analyzer{withFacts}, nil)).WithLoadForGoAnalysis(),
+			},
+			expected: true,
+		},
+		{
+			desc: "facts of a required analyzer",
+			linters: []*linter.Config{
+				linter.NewConfig(goanalysis.NewLinter("a", "", []*analysis.Analyzer{requiringFacts}, nil)).WithLoadForGoAnalysis(),
+			},
+			expected: true,
+		},
+		{
+			desc: "whole program",
+			linters: []*linter.Config{
+				linter.NewConfig(goanalysis.NewLinter("a", "", []*analysis.Analyzer{withoutFacts}, nil).
+					WithLoadMode(goanalysis.LoadModeWholeProgram)).WithLoadForGoAnalysis(),
+			},
+			expected: true,
+		},
+		{
+			desc: "facts without types",
+			linters: []*linter.Config{
+				linter.NewConfig(goanalysis.NewLinter("a", "", []*analysis.Analyzer{withFacts}, nil)),
+			},
+		},
+		{
+			desc: "metalinter",
+			linters: []*linter.Config{
+				linter.NewConfig(goanalysis.NewMetaLinter([]*goanalysis.Linter{
+					goanalysis.NewLinter("a", "", []*analysis.Analyzer{withoutFacts}, nil),
+					goanalysis.NewLinter("b", "", []*analysis.Analyzer{withFacts}, nil),
+				})).WithLoadForGoAnalysis(),
+			},
+			expected: true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, needDependents(test.linters))
+		})
+	}
+}
+
+// testChangedPackages returns packages: b imports a, c imports b, and a has internal and external tests.
+func testChangedPackages(t *testing.T) []*packages.Package {
+	t.Helper()
+
+	pkgA := &packages.Package{
+		ID:      "example.com/a",
+		PkgPath: "example.com/a",
+		GoFiles: []string{testChangedPath(t, "a/a.go")},
+	}
+
+	pkgATest := &packages.Package{
+		ID:      "example.com/a [example.com/a.test]",
+		PkgPath: "example.com/a",
+		GoFiles: []string{testChangedPath(t, "a/a.go"), testChangedPath(t, "a/a_test.go")},
+	}
+
+	pkgAXTest := &packages.Package{
+		ID:      "example.com/a_test [example.com/a.test]",
+		PkgPath: "example.com/a_test",
+		GoFiles: []string{testChangedPath(t, "a/x_test.go")},
+		Imports: map[string]*packages.Package{"example.com/a": {ID: pkgATest.ID}},
+	}
+
+	pkgB := &packages.Package{
+		ID:         "example.com/b",
+		PkgPath:    "example.com/b",
+		GoFiles:    []string{testChangedPath(t, "b/b.go")},
+		EmbedFiles: []string{testChangedPath(t, "b/data.txt")},
+		Imports:    map[string]*packages.Package{"example.com/a": {ID: pkgA.ID}},
+	}
+
+	pkgC := &packages.Package{
+		ID:      "example.com/c",
+		PkgPath: "example.com/c",
+		GoFiles: []string{testChangedPath(t, "c/c.go")},
+		Imports: map[string]*packages.Package{"example.com/b": {ID: pkgB.ID}},
+	}
+
+	return []*packages.Package{pkgA, pkgATest, pkgAXTest, pkgB, pkgC}
+}
+
+func testChangedPath(t *testing.T, path string) string {
+	t.Helper()
+
+	return mustAbs(t, "/tmp/changed/"+path)
+}
+
+func changedIDs(changed map[string]bool) []string {
+	var ids []string
+	for id := range changed {
+		ids = append(ids, id)
+	}
+
+	return ids
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/golangci/golangci-lint/pkg/exitcodes"
 	"github.com/golangci/golangci-lint/pkg/fsutils"
 	"github.com/golangci/golangci-lint/pkg/goanalysis/load"
+	"github.com/golangci/golangci-lint/pkg/goanalysis/profile"
 	"github.com/golangci/golangci-lint/pkg/lint/linter"
 	"github.com/golangci/golangci-lint/pkg/logutils"
 )
@@ -22,6 +23,8 @@ type ContextBuilder struct {
 	pkgCache  *pkgcache.Cache
 
 	loadGuard *load.Guard
+
+	profiler *profile.Profiler
 }
 
 func NewContextBuilder(cfg *config.Config, pkgLoader *PackageLoader,
@@ -58,7 +61,15 @@ func (cl *ContextBuilder) Build(ctx context.Context, log logutils.Log, linters [
 		FileCache: cl.fileCache,
 		PkgCache:  cl.pkgCache,
 		LoadGuard: cl.loadGuard,
+		Overlay:   cl.pkgLoader.overlay,
+		Profiler:  cl.profiler,
+		RunCache:  linter.NewRunCache(),
 	}
 
 	return ret, nil
 }
+
+// SetProfiler measures the cost of the analyzers of the built contexts.
+func (cl *ContextBuilder) SetProfiler(profiler *profile.Profiler) {
+	cl.profiler = profiler
+}
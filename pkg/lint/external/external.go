@@ -0,0 +1,268 @@
+// Package external runs the external tools configured as `command` custom linters.
+package external
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+var _ linter.Linter = (*Linter)(nil)
+
+// Linter runs a command, once per run or once per package, and parses its output into issues.
+type Linter struct {
+	name string
+	desc string
+
+	command string
+	args    []string
+	scope   string
+
+	parse parseFunc
+}
+
+// NewLinter creates a linter from the settings of a `command` custom linter.
+func NewLinter(name string, settings *config.CustomLinterSettings, configDir string) (*Linter, error) {
+	p, err := newParser(settings.Format, settings.Pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := settings.Scope
+	if scope == "" {
+		scope = config.CommandScopeRun
+	}
+
+	return &Linter{
+		name:    name,
+		desc:    settings.Description,
+		command: resolveCommand(settings.Command, configDir),
+		args:    settings.Args,
+		scope:   scope,
+		parse:   p,
+	}, nil
+}
+
+func (l *Linter) Name() string {
+	return l.name
+}
+
+func (l *Linter) Desc() string {
+	return l.desc
+}
+
+func (l *Linter) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	if l.scope == config.CommandScopeRun {
+		return l.runOnce(ctx, lintCtx)
+	}
+
+	var issues []result.Issue
+
+	seen := map[string]bool{}
+
+	for _, pkg := range lintCtx.Packages {
+		dir := packageDir(pkg)
+		if dir == "" || seen[dir] {
+			continue
+		}
+
+		seen[dir] = true
+
+		replacer := strings.NewReplacer("{dir}", dir, "{package}", pkg.PkgPath)
+
+		args := make([]string, 0, len(l.args))
+		for _, arg := range l.args {
+			args = append(args, replacer.Replace(arg))
+		}
+
+		pkgIssues, err := l.execute(ctx, dir, args)
+		if err != nil {
+			return nil, fmt.Errorf("package %s: %w", pkg.PkgPath, err)
+		}
+
+		// Only the issues of the directory of the package are kept:
+		// the issues of the sub-directories are reported by their own packages.
+		for i := range pkgIssues {
+			if filepath.Dir(pkgIssues[i].FilePath()) != filepath.Clean(dir) {
+				continue
+			}
+
+			pkgIssues[i].Pkg = pkg
+			issues = append(issues, pkgIssues[i])
+		}
+	}
+
+	return issues, nil
+}
+
+// runOnce runs the command once per run: with the overrides, the linter runs once per scope.
+// The issues of the Go files are kept only if they are inside the directories of the analyzed packages of the scope.
+// The issues of the other files (ex: `.proto` files) are kept if they are inside the working directory or a module:
+// they are reported only by the scope running the command.
+func (l *Linter) runOnce(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	key := strings.Join(append([]string{l.name, l.command}, l.args...), "\x00")
+
+	var owner bool
+
+	v, err := lintCtx.RunCache.Do(key, func() (any, error) {
+		owner = true
+		return l.execute(ctx, "", l.args)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dirs := packageDirs(lintCtx.Packages)
+
+	var roots []string
+	if owner {
+		roots, err = rootDirs(lintCtx.Packages)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	runIssues := v.([]result.Issue)
+
+	var issues []result.Issue
+
+	for i := range runIssues {
+		issue := runIssues[i]
+
+		if filepath.Ext(issue.FilePath()) == ".go" {
+			pkg, ok := dirs[filepath.Dir(issue.FilePath())]
+			if !ok {
+				continue
+			}
+
+			issue.Pkg = pkg
+		} else if !slices.ContainsFunc(roots, func(root string) bool { return isWithin(issue.FilePath(), root) }) {
+			lintCtx.Log.Infof("Skip the issue of %s: %s is outside the working directory and the modules", l.name, issue.FilePath())
+			continue
+		}
+
+		issues = append(issues, issue)
+	}
+
+	return issues, nil
+}
+
+// execute runs the command in a directory (the working directory if empty), and parses its output.
+// Most of the tools exit with a non-zero code when they report issues:
+// a non-zero exit code is an error only if there is no issue in the output.
+func (l *Linter) execute(ctx context.Context, dir string, args []string) ([]result.Issue, error) {
+	stdout := new(bytes.Buffer)
+	stderr := new(bytes.Buffer)
+
+	//nolint:gosec // The command and its arguments are defined by the configuration.
+	cmd := exec.CommandContext(ctx, l.command, args...)
+	cmd.Dir = dir
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	runErr := cmd.Run()
+
+	var exitErr *exec.ExitError
+	if runErr != nil && !errors.As(runErr, &exitErr) {
+		return nil, fmt.Errorf("run %s: %w", l.command, runErr)
+	}
+
+	issues, err := l.parse(stdout.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("parse the output of %s: %w", l.command, err)
+	}
+
+	if runErr != nil && len(issues) == 0 {
+		return nil, fmt.Errorf("run %s: %w: %s", l.command, runErr, strings.TrimSpace(stderr.String()))
+	}
+
+	base, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range issues {
+		issues[i].FromLinter = l.name
+
+		if !filepath.IsAbs(issues[i].Pos.Filename) {
+			issues[i].Pos.Filename = filepath.Join(base, issues[i].Pos.Filename)
+		}
+	}
+
+	return issues, nil
+}
+
+// resolveCommand resolves a relative path to a command from the directory of the configuration file.
+// A command without path separator is looked up in the PATH.
+func resolveCommand(command, configDir string) string {
+	if filepath.IsAbs(command) || !strings.ContainsAny(command, `/\`) {
+		return command
+	}
+
+	return filepath.Join(configDir, command)
+}
+
+// packageDirs returns the packages by directory.
+func packageDirs(pkgs []*packages.Package) map[string]*packages.Package {
+	dirs := map[string]*packages.Package{}
+
+	for _, pkg := range pkgs {
+		if dir := packageDir(pkg); dir != "" {
+			dirs[filepath.Clean(dir)] = pkg
+		}
+	}
+
+	return dirs
+}
+
+// rootDirs returns the working directory and the root directories of the modules of the packages.
+func rootDirs(pkgs []*packages.Package) ([]string, error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("get the working directory: %w", err)
+	}
+
+	roots := []string{wd}
+
+	for _, pkg := range pkgs {
+		if pkg.Module != nil && pkg.Module.Dir != "" && !slices.Contains(roots, pkg.Module.Dir) {
+			roots = append(roots, pkg.Module.Dir)
+		}
+	}
+
+	return roots, nil
+}
+
+func isWithin(path, dir string) bool {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false
+	}
+
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+func packageDir(pkg *packages.Package) string {
+	files := pkg.GoFiles
+	if len(files) == 0 {
+		files = pkg.CompiledGoFiles
+	}
+
+	if len(files) == 0 {
+		return ""
+	}
+
+	return filepath.Dir(files[0])
+}
@@ -0,0 +1,188 @@
+package external
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+const (
+	helperEnv     = "GOLANGCI_LINT_EXTERNAL_HELPER"
+	helperRunsEnv = "GOLANGCI_LINT_EXTERNAL_HELPER_RUNS"
+)
+
+// TestHelperProcess is the command run by the tests: it prints an issue for its last argument.
+func TestHelperProcess(_ *testing.T) {
+	if os.Getenv(helperEnv) == "" {
+		return
+	}
+
+	if os.Getenv(helperEnv) == "fail" {
+		fmt.Fprintln(os.Stderr, "something went wrong")
+		os.Exit(2)
+	}
+
+	// Counts the executions of the command.
+	if runs := os.Getenv(helperRunsEnv); runs != "" {
+		f, err := os.OpenFile(runs, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			panic(err)
+		}
+
+		_, _ = fmt.Fprintln(f, "run")
+		_ = f.Close()
+	}
+
+	fmt.Printf("sample.go:10:4: issue for %s\n", os.Args[len(os.Args)-1])
+
+	if os.Args[len(os.Args)-1] == "run" {
+		fmt.Println("other/other.go:1:1: other issue")
+		fmt.Println("proto/service.proto:3:1: proto issue")
+		fmt.Println("../outside.sql:1:1: outside issue")
+	}
+
+	// Like most of the tools, the exit code is not zero when there are issues.
+	os.Exit(1)
+}
+
+func newHelperLinter(t *testing.T, scope string, args ...string) *Linter {
+	t.Helper()
+
+	l, err := NewLinter("example", &config.CustomLinterSettings{
+		Type:    "command",
+		Command: os.Args[0],
+		Args:    append([]string{"-test.run=TestHelperProcess", "--"}, args...),
+		Scope:   scope,
+	}, "")
+	require.NoError(t, err)
+
+	return l
+}
+
+func TestLinter_Run(t *testing.T) {
+	t.Setenv(helperEnv, "1")
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+
+	pkg := &packages.Package{PkgPath: "example.com/sample", GoFiles: []string{filepath.Join(wd, "sample.go")}}
+
+	l := newHelperLinter(t, config.CommandScopeRun, "run")
+
+	issues, err := l.Run(context.Background(), &linter.Context{
+		Packages: []*packages.Package{pkg},
+		Log:      logutils.NewStderrLog(logutils.DebugKeyEmpty),
+	})
+	require.NoError(t, err)
+
+	// The issue of other/other.go is outside the directories of the analyzed packages,
+	// and the issue of outside.sql is outside the working directory.
+	require.Len(t, issues, 2)
+
+	assert.Equal(t, "example", issues[0].FromLinter)
+	assert.Equal(t, "issue for run", issues[0].Text)
+	assert.Equal(t, filepath.Join(wd, "sample.go"), issues[0].FilePath())
+	assert.Equal(t, 10, issues[0].Line())
+	assert.Same(t, pkg, issues[0].Pkg)
+
+	// The issues of the files other than Go files are kept.
+	assert.Equal(t, "proto issue", issues[1].Text)
+	assert.Equal(t, filepath.Join(wd, "proto", "service.proto"), issues[1].FilePath())
+	assert.Nil(t, issues[1].Pkg)
+}
+
+func TestLinter_Run_scopes(t *testing.T) {
+	t.Setenv(helperEnv, "1")
+
+	runs := filepath.Join(t.TempDir(), "runs")
+	t.Setenv(helperRunsEnv, runs)
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+
+	runCache := linter.NewRunCache()
+
+	// The contexts of the scopes of the overrides share the cache of the run.
+	scopeCtxs := []*linter.Context{
+		{
+			Packages: []*packages.Package{{PkgPath: "example.com/sample", GoFiles: []string{filepath.Join(wd, "sample.go")}}},
+			Log:      logutils.NewStderrLog(logutils.DebugKeyEmpty),
+			RunCache: runCache,
+		},
+		{
+			Packages: []*packages.Package{{PkgPath: "example.com/other", GoFiles: []string{filepath.Join(wd, "other", "other.go")}}},
+			Log:      logutils.NewStderrLog(logutils.DebugKeyEmpty),
+			RunCache: runCache,
+		},
+	}
+
+	var texts []string
+
+	for _, lintCtx := range scopeCtxs {
+		// The linters of each scope are distinct instances.
+		issues, errR := newHelperLinter(t, config.CommandScopeRun, "run").Run(context.Background(), lintCtx)
+		require.NoError(t, errR)
+
+		for _, issue := range issues {
+			texts = append(texts, issue.Text)
+		}
+	}
+
+	// The issue of the Protobuf file is reported once, by the scope running the command.
+	assert.Equal(t, []string{"issue for run", "proto issue", "other issue"}, texts)
+
+	data, err := os.ReadFile(runs)
+	require.NoError(t, err)
+
+	assert.Equal(t, "run\n", string(data))
+}
+
+func TestLinter_Run_package(t *testing.T) {
+	t.Setenv(helperEnv, "1")
+
+	dirA := t.TempDir()
+	dirB := t.TempDir()
+
+	lintCtx := &linter.Context{
+		Packages: []*packages.Package{
+			{PkgPath: "example.com/a", GoFiles: []string{filepath.Join(dirA, "a.go")}},
+			{PkgPath: "example.com/a_test", GoFiles: []string{filepath.Join(dirA, "a_test.go")}},
+			{PkgPath: "example.com/b", GoFiles: []string{filepath.Join(dirB, "b.go")}},
+		},
+		Log: logutils.NewStderrLog(logutils.DebugKeyEmpty),
+	}
+
+	l := newHelperLinter(t, config.CommandScopePackage, "{package}")
+
+	issues, err := l.Run(context.Background(), lintCtx)
+	require.NoError(t, err)
+
+	require.Len(t, issues, 2)
+
+	assert.Equal(t, "issue for example.com/a", issues[0].Text)
+	assert.Equal(t, filepath.Join(dirA, "sample.go"), issues[0].FilePath())
+
+	assert.Equal(t, "issue for example.com/b", issues[1].Text)
+	assert.Equal(t, filepath.Join(dirB, "sample.go"), issues[1].FilePath())
+}
+
+func TestLinter_Run_error(t *testing.T) {
+	t.Setenv(helperEnv, "fail")
+
+	l := newHelperLinter(t, config.CommandScopeRun, "run")
+
+	_, err := l.Run(context.Background(), &linter.Context{Log: logutils.NewStderrLog(logutils.DebugKeyEmpty)})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "exit status 2: something went wrong")
+}
@@ -0,0 +1,265 @@
+package external
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"go/token"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// parseFunc parses the output of a command into issues.
+// The file paths of the issues can be relative to the directory of the command.
+type parseFunc func(data []byte) ([]result.Issue, error)
+
+func newParser(format, pattern string) (parseFunc, error) {
+	switch format {
+	case "", config.CommandFormatLine:
+		if pattern == "" {
+			pattern = config.DefaultCommandPattern
+		}
+
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern: %w", err)
+		}
+
+		return func(data []byte) ([]result.Issue, error) {
+			return parseLines(re, data)
+		}, nil
+
+	case config.CommandFormatSARIF:
+		return parseSARIF, nil
+
+	case config.CommandFormatCheckstyle:
+		return parseCheckstyle, nil
+
+	case config.CommandFormatJSON:
+		return parseJSON, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+// parseLines parses the lines matching the pattern: the other lines are ignored.
+func parseLines(re *regexp.Regexp, data []byte) ([]result.Issue, error) {
+	var issues []result.Issue
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		match := re.FindStringSubmatch(scanner.Text())
+		if match == nil {
+			continue
+		}
+
+		group := func(name string) string {
+			if i := re.SubexpIndex(name); i >= 0 {
+				return strings.TrimSpace(match[i])
+			}
+
+			return ""
+		}
+
+		line, err := strconv.Atoi(group("line"))
+		if err != nil {
+			continue
+		}
+
+		// The column is optional.
+		column, _ := strconv.Atoi(group("col"))
+
+		issues = append(issues, newIssue(group("file"), line, column, group("rule"), group("message"), group("severity")))
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return issues, nil
+}
+
+type sarifLog struct {
+	Runs []struct {
+		Results []sarifResult `json:"results"`
+	} `json:"runs"`
+}
+
+type sarifResult struct {
+	RuleID  string `json:"ruleId"`
+	Level   string `json:"level"`
+	Message struct {
+		Text string `json:"text"`
+	} `json:"message"`
+	Locations []struct {
+		PhysicalLocation struct {
+			ArtifactLocation struct {
+				URI string `json:"uri"`
+			} `json:"artifactLocation"`
+			Region struct {
+				StartLine   int `json:"startLine"`
+				StartColumn int `json:"startColumn"`
+				EndLine     int `json:"endLine"`
+				EndColumn   int `json:"endColumn"`
+			} `json:"region"`
+		} `json:"physicalLocation"`
+	} `json:"locations"`
+}
+
+// parseSARIF parses the results of all the runs: the results without location are ignored.
+func parseSARIF(data []byte) ([]result.Issue, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, nil
+	}
+
+	var log sarifLog
+
+	err := json.Unmarshal(data, &log)
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []result.Issue
+
+	for _, run := range log.Runs {
+		for i := range run.Results {
+			sr := &run.Results[i]
+
+			if len(sr.Locations) == 0 || sr.Locations[0].PhysicalLocation.Region.StartLine == 0 {
+				continue
+			}
+
+			location := sr.Locations[0].PhysicalLocation
+
+			filename, err := uriToPath(location.ArtifactLocation.URI)
+			if err != nil {
+				return nil, err
+			}
+
+			issue := newIssue(filename, location.Region.StartLine, location.Region.StartColumn,
+				sr.RuleID, sr.Message.Text, sr.Level)
+
+			if location.Region.EndLine > 0 || location.Region.EndColumn > 0 {
+				issue.End = &result.Position{
+					Line:   max(location.Region.EndLine, location.Region.StartLine),
+					Column: location.Region.EndColumn,
+				}
+			}
+
+			issues = append(issues, issue)
+		}
+	}
+
+	return issues, nil
+}
+
+// uriToPath converts the URI of a SARIF artifact (absolute `file://` URI or relative reference) into a file path.
+func uriToPath(uri string) (string, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", fmt.Errorf("invalid URI %q: %w", uri, err)
+	}
+
+	if u.Scheme != "" && u.Scheme != "file" {
+		return "", fmt.Errorf("unsupported URI %q", uri)
+	}
+
+	return u.Path, nil
+}
+
+type checkstyleOutput struct {
+	Files []struct {
+		Name   string `xml:"name,attr"`
+		Errors []struct {
+			Line     int    `xml:"line,attr"`
+			Column   int    `xml:"column,attr"`
+			Severity string `xml:"severity,attr"`
+			Message  string `xml:"message,attr"`
+			Source   string `xml:"source,attr"`
+		} `xml:"error"`
+	} `xml:"file"`
+}
+
+func parseCheckstyle(data []byte) ([]result.Issue, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, nil
+	}
+
+	var output checkstyleOutput
+
+	err := xml.Unmarshal(data, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []result.Issue
+
+	for _, file := range output.Files {
+		for _, e := range file.Errors {
+			issues = append(issues, newIssue(file.Name, e.Line, e.Column, e.Source, e.Message, e.Severity))
+		}
+	}
+
+	return issues, nil
+}
+
+// parseJSON parses the JSON output of golangci-lint:
+// the name of the linter reporting an issue becomes the rule of the issue.
+func parseJSON(data []byte) ([]result.Issue, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, nil
+	}
+
+	var output struct {
+		Issues []result.Issue
+	}
+
+	err := json.Unmarshal(data, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	issues := make([]result.Issue, 0, len(output.Issues))
+
+	for i := range output.Issues {
+		in := &output.Issues[i]
+
+		issues = append(issues, result.Issue{
+			Text:      in.Text,
+			RuleID:    in.FromLinter,
+			Severity:  in.Severity,
+			LineRange: in.LineRange,
+			Pos:       in.Pos,
+			End:       in.End,
+		})
+	}
+
+	return issues, nil
+}
+
+// newIssue creates an issue: the text is prefixed with the rule, like the issues of the other linters.
+func newIssue(filename string, line, column int, rule, message, severity string) result.Issue {
+	text := message
+	if rule != "" {
+		text = rule + ": " + message
+	}
+
+	return result.Issue{
+		Text:     text,
+		RuleID:   rule,
+		Severity: strings.ToLower(severity),
+		Pos: token.Position{
+			Filename: filename,
+			Line:     line,
+			Column:   column,
+		},
+	}
+}
@@ -0,0 +1,164 @@
+package external
+
+import (
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		format   string
+		pattern  string
+		data     string
+		expected []result.Issue
+	}{
+		{
+			desc:   "line",
+			format: config.CommandFormatLine,
+			data: `sample.go:10:4: some issue
+# a line without issue
+dir/other.go:3: another issue
+`,
+			expected: []result.Issue{
+				{Text: "some issue", Pos: token.Position{Filename: "sample.go", Line: 10, Column: 4}},
+				{Text: "another issue", Pos: token.Position{Filename: "dir/other.go", Line: 3}},
+			},
+		},
+		{
+			desc:    "line with pattern",
+			pattern: `^(?P<severity>\w+) (?P<file>[^:]+):(?P<line>\d+) \[(?P<rule>[\w-]+)\] (?P<message>.+)$`,
+			data: `WARNING migrations/0001.sql:2 [no-drop] DROP TABLE is forbidden
+`,
+			expected: []result.Issue{
+				{
+					Text:     "no-drop: DROP TABLE is forbidden",
+					RuleID:   "no-drop",
+					Severity: "warning",
+					Pos:      token.Position{Filename: "migrations/0001.sql", Line: 2},
+				},
+			},
+		},
+		{
+			desc:   "sarif",
+			format: config.CommandFormatSARIF,
+			data: `{"version":"2.1.0","runs":[{"results":[
+{"ruleId":"R1","level":"error","message":{"text":"some issue"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"sample.go"},"region":{"startLine":10,"startColumn":4,"endColumn":8}}}]},
+{"ruleId":"R2","level":"note","message":{"text":"another issue"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"file:///abs/other.go"},"region":{"startLine":3}}}]},
+{"ruleId":"R3","message":{"text":"without location"}}
+]}]}`,
+			expected: []result.Issue{
+				{
+					Text:     "R1: some issue",
+					RuleID:   "R1",
+					Severity: "error",
+					Pos:      token.Position{Filename: "sample.go", Line: 10, Column: 4},
+					End:      &result.Position{Line: 10, Column: 8},
+				},
+				{
+					Text:     "R2: another issue",
+					RuleID:   "R2",
+					Severity: "note",
+					Pos:      token.Position{Filename: "/abs/other.go", Line: 3},
+				},
+			},
+		},
+		{
+			desc:   "checkstyle",
+			format: config.CommandFormatCheckstyle,
+			data: `<?xml version="1.0" encoding="UTF-8"?>
+<checkstyle version="5.0">
+  <file name="sample.go">
+    <error line="10" column="4" severity="error" message="some issue" source="R1"></error>
+  </file>
+</checkstyle>`,
+			expected: []result.Issue{
+				{
+					Text:     "R1: some issue",
+					RuleID:   "R1",
+					Severity: "error",
+					Pos:      token.Position{Filename: "sample.go", Line: 10, Column: 4},
+				},
+			},
+		},
+		{
+			desc:   "json",
+			format: config.CommandFormatJSON,
+			data: `{"Issues":[{"FromLinter":"govet","Text":"printf: wrong format","Severity":"","Pos":{"Filename":"sample.go","Offset":12,"Line":10,"Column":4}}],"Report":{}}
+`,
+			expected: []result.Issue{
+				{
+					Text:   "printf: wrong format",
+					RuleID: "govet",
+					Pos:    token.Position{Filename: "sample.go", Offset: 12, Line: 10, Column: 4},
+				},
+			},
+		},
+		{
+			desc:   "empty sarif",
+			format: config.CommandFormatSARIF,
+			data:   "\n",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			parse, err := newParser(test.format, test.pattern)
+			require.NoError(t, err)
+
+			issues, err := parse([]byte(test.data))
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, issues)
+		})
+	}
+}
+
+func TestParse_error(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		format   string
+		data     string
+		expected string
+	}{
+		{
+			desc:     "invalid sarif",
+			format:   config.CommandFormatSARIF,
+			data:     "sample.go:10:4: some issue",
+			expected: "invalid character 's' looking for beginning of value",
+		},
+		{
+			desc:     "unsupported URI",
+			format:   config.CommandFormatSARIF,
+			data:     `{"runs":[{"results":[{"locations":[{"physicalLocation":{"artifactLocation":{"uri":"https://example.com/sample.go"},"region":{"startLine":1}}}]}]}]}`,
+			expected: `unsupported URI "https://example.com/sample.go"`,
+		},
+		{
+			desc:     "invalid checkstyle",
+			format:   config.CommandFormatCheckstyle,
+			data:     "<checkstyle>",
+			expected: "XML syntax error on line 1: unexpected EOF",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			parse, err := newParser(test.format, "")
+			require.NoError(t, err)
+
+			_, err = parse([]byte(test.data))
+
+			assert.EqualError(t, err, test.expected)
+		})
+	}
+}
@@ -0,0 +1,472 @@
+package external
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/token"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+const vetConfigFileMode = 0o600
+
+var _ linter.Linter = (*VetTool)(nil)
+
+// vetConfig is the configuration of a unitchecker binary (`go vet -vettool`) for a package.
+//
+// https://pkg.go.dev/golang.org/x/tools/go/analysis/unitchecker#Config
+type vetConfig struct {
+	ID                        string
+	Compiler                  string
+	Dir                       string
+	ImportPath                string
+	GoVersion                 string
+	GoFiles                   []string
+	NonGoFiles                []string
+	IgnoredFiles              []string
+	ModulePath                string
+	ModuleVersion             string
+	ImportMap                 map[string]string
+	PackageFile               map[string]string
+	PackageVetx               map[string]string
+	VetxOnly                  bool
+	VetxOutput                string
+	SucceedOnTypecheckFailure bool
+}
+
+// vetDiagnostic is a diagnostic of the JSON output (`-json`) of a unitchecker binary.
+type vetDiagnostic struct {
+	Posn           string `json:"posn"`
+	Message        string `json:"message"`
+	SuggestedFixes []struct {
+		Message string `json:"message"`
+		Edits   []struct {
+			Filename string `json:"filename"`
+			Start    int    `json:"start"`
+			End      int    `json:"end"`
+			New      string `json:"new"`
+		} `json:"edits"`
+	} `json:"suggested_fixes"`
+	Related []struct {
+		Posn    string `json:"posn"`
+		Message string `json:"message"`
+	} `json:"related"`
+}
+
+// VetTool runs a unitchecker binary, like `go vet -vettool` does, and converts its diagnostics into issues.
+//
+// The binary is run for each analyzed package to get the diagnostics,
+// and for each dependency outside the standard library to get the facts used by the analyzers.
+type VetTool struct {
+	name string
+	desc string
+
+	command string
+	args    []string
+}
+
+// NewVetTool creates a linter from the settings of a `vettool` custom linter.
+func NewVetTool(name string, settings *config.CustomLinterSettings, configDir string) *VetTool {
+	return &VetTool{
+		name:    name,
+		desc:    settings.Description,
+		command: resolveCommand(settings.Command, configDir),
+		args:    settings.Args,
+	}
+}
+
+func (v *VetTool) Name() string {
+	return v.name
+}
+
+func (v *VetTool) Desc() string {
+	return v.desc
+}
+
+func (v *VetTool) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+	dir, err := os.MkdirTemp("", "golangci-lint-vettool-")
+	if err != nil {
+		return nil, err
+	}
+
+	defer os.RemoveAll(dir)
+
+	r := &vetRun{
+		tool:     v,
+		log:      lintCtx.Log,
+		dir:      dir,
+		analyzed: map[*packages.Package]bool{},
+		units:    map[*packages.Package]*vetUnit{},
+		sem:      make(chan struct{}, runtime.GOMAXPROCS(-1)),
+	}
+
+	for _, pkg := range lintCtx.Packages {
+		r.analyzed[pkg] = true
+	}
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		issues []result.Issue
+		errs   []error
+	)
+
+	for _, pkg := range lintCtx.Packages {
+		wg.Add(1)
+
+		go func(pkg *packages.Package) {
+			defer wg.Done()
+
+			unit := r.run(ctx, pkg)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if unit.err != nil {
+				errs = append(errs, fmt.Errorf("package %s: %w", pkg.ID, unit.err))
+				return
+			}
+
+			issues = append(issues, unit.issues...)
+		}(pkg)
+	}
+
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return issues, nil
+}
+
+// vetUnit is the execution of the binary for a package.
+type vetUnit struct {
+	once sync.Once
+
+	name   string // The path of the files of the unit, without extension.
+	vetx   string // The file of the facts, empty if the facts are unavailable.
+	issues []result.Issue
+	err    error
+}
+
+type vetRun struct {
+	tool *VetTool
+	log  logutils.Log
+	dir  string
+
+	// analyzed are the packages with diagnostics: the other packages are run only for their facts.
+	analyzed map[*packages.Package]bool
+
+	mu    sync.Mutex
+	units map[*packages.Package]*vetUnit
+
+	// sem limits the number of concurrent executions of the binary.
+	sem chan struct{}
+}
+
+// run runs the binary for a package, after its dependencies.
+func (r *vetRun) run(ctx context.Context, pkg *packages.Package) *vetUnit {
+	r.mu.Lock()
+	unit, ok := r.units[pkg]
+	if !ok {
+		unit = &vetUnit{name: filepath.Join(r.dir, strconv.Itoa(len(r.units)))}
+		r.units[pkg] = unit
+	}
+	r.mu.Unlock()
+
+	unit.once.Do(func() {
+		var wg sync.WaitGroup
+
+		for _, imp := range pkg.Imports {
+			if isStandard(imp) {
+				continue
+			}
+
+			wg.Add(1)
+
+			go func(imp *packages.Package) {
+				defer wg.Done()
+
+				r.run(ctx, imp)
+			}(imp)
+		}
+
+		wg.Wait()
+
+		r.sem <- struct{}{}
+		defer func() { <-r.sem }()
+
+		unit.issues, unit.err = r.execute(ctx, pkg, unit.name)
+		if unit.err != nil {
+			if !r.analyzed[pkg] {
+				r.log.Warnf("%s: can't compute the facts of %s: %v", r.tool.name, pkg.ID, unit.err)
+			}
+
+			return
+		}
+
+		unit.vetx = unit.name + ".vetx"
+	})
+
+	return unit
+}
+
+func (r *vetRun) execute(ctx context.Context, pkg *packages.Package, name string) ([]result.Issue, error) {
+	cfg := r.buildConfig(pkg, name+".vetx")
+
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	cfgFile := name + ".cfg"
+
+	err = os.WriteFile(cfgFile, data, vetConfigFileMode)
+	if err != nil {
+		return nil, err
+	}
+
+	stdout := new(bytes.Buffer)
+	stderr := new(bytes.Buffer)
+
+	args := append(slices.Clone(r.tool.args), "-json", cfgFile)
+
+	//nolint:gosec // The command and its arguments are defined by the configuration.
+	cmd := exec.CommandContext(ctx, r.tool.command, args...)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	err = cmd.Run()
+	if err != nil {
+		return nil, fmt.Errorf("run %s: %w: %s", r.tool.command, err, strings.TrimSpace(stderr.String()))
+	}
+
+	if cfg.VetxOnly {
+		return nil, nil
+	}
+
+	return r.parse(pkg, stdout.Bytes())
+}
+
+// buildConfig builds the configuration of a package:
+// the export data and the facts of the dependencies come from the transitive imports of the package.
+func (r *vetRun) buildConfig(pkg *packages.Package, vetx string) *vetConfig {
+	cfg := &vetConfig{
+		ID:                        pkg.ID,
+		Compiler:                  "gc",
+		Dir:                       packageDir(pkg),
+		ImportPath:                pkg.PkgPath,
+		GoFiles:                   pkg.CompiledGoFiles,
+		NonGoFiles:                pkg.OtherFiles,
+		IgnoredFiles:              pkg.IgnoredFiles,
+		ImportMap:                 map[string]string{},
+		PackageFile:               map[string]string{},
+		PackageVetx:               map[string]string{},
+		VetxOnly:                  !r.analyzed[pkg],
+		VetxOutput:                vetx,
+		SucceedOnTypecheckFailure: true,
+	}
+
+	if pkg.Module != nil {
+		cfg.ModulePath = pkg.Module.Path
+		cfg.ModuleVersion = pkg.Module.Version
+
+		if pkg.Module.GoVersion != "" {
+			cfg.GoVersion = "go" + pkg.Module.GoVersion
+		}
+	}
+
+	for path, imp := range pkg.Imports {
+		cfg.ImportMap[path] = imp.PkgPath
+	}
+
+	seen := map[*packages.Package]bool{}
+
+	var visit func(p *packages.Package)
+	visit = func(p *packages.Package) {
+		for _, imp := range p.Imports {
+			if seen[imp] {
+				continue
+			}
+
+			seen[imp] = true
+
+			if imp.ExportFile != "" {
+				cfg.PackageFile[imp.PkgPath] = imp.ExportFile
+			}
+
+			if vetx := r.vetx(imp); vetx != "" {
+				cfg.PackageVetx[imp.PkgPath] = vetx
+			}
+
+			visit(imp)
+		}
+	}
+
+	visit(pkg)
+
+	return cfg
+}
+
+func (r *vetRun) vetx(pkg *packages.Package) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	unit, ok := r.units[pkg]
+	if !ok {
+		return ""
+	}
+
+	return unit.vetx
+}
+
+// parse converts the JSON output of the binary (diagnostics per package and per analyzer) into issues.
+func (r *vetRun) parse(pkg *packages.Package, data []byte) ([]result.Issue, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, nil
+	}
+
+	var tree map[string]map[string]json.RawMessage
+
+	err := json.Unmarshal(data, &tree)
+	if err != nil {
+		return nil, fmt.Errorf("parse the output of %s: %w", r.tool.command, err)
+	}
+
+	var issues []result.Issue
+
+	for _, analyzers := range tree {
+		for analyzer, raw := range analyzers {
+			var diags []vetDiagnostic
+
+			if err := json.Unmarshal(raw, &diags); err != nil {
+				var analyzerErr struct {
+					Err string `json:"error"`
+				}
+
+				if errE := json.Unmarshal(raw, &analyzerErr); errE != nil {
+					return nil, fmt.Errorf("parse the output of %s: %w", r.tool.command, err)
+				}
+
+				r.log.Warnf("%s: analyzer %s failed on %s: %s", r.tool.name, analyzer, pkg.ID, analyzerErr.Err)
+
+				continue
+			}
+
+			for i := range diags {
+				issues = append(issues, r.buildIssues(pkg, analyzer, &diags[i])...)
+			}
+		}
+	}
+
+	return issues, nil
+}
+
+// buildIssues builds the issues of a diagnostic, like the issues of the go/analysis linters:
+// the analyzers are the rules of the linter.
+func (r *vetRun) buildIssues(pkg *packages.Package, analyzer string, diag *vetDiagnostic) []result.Issue {
+	var text, ruleID string
+	if analyzer == r.tool.name {
+		text = diag.Message
+	} else {
+		text = fmt.Sprintf("%s: %s", analyzer, diag.Message)
+		ruleID = analyzer
+	}
+
+	var fixes []result.SuggestedFix
+	for _, fix := range diag.SuggestedFixes {
+		suggestedFix := result.SuggestedFix{Message: fix.Message}
+
+		for _, edit := range fix.Edits {
+			suggestedFix.TextEdits = append(suggestedFix.TextEdits, result.TextEdit{
+				Filename: edit.Filename,
+				Start:    edit.Start,
+				End:      edit.End,
+				NewText:  edit.New,
+			})
+		}
+
+		fixes = append(fixes, suggestedFix)
+	}
+
+	issues := []result.Issue{{
+		FromLinter:     r.tool.name,
+		Text:           text,
+		RuleID:         ruleID,
+		Pos:            parsePosn(diag.Posn),
+		Pkg:            pkg,
+		SuggestedFixes: fixes,
+	}}
+
+	for _, info := range diag.Related {
+		issues = append(issues, result.Issue{
+			FromLinter: r.tool.name,
+			Text:       fmt.Sprintf("%s(related information): %s", analyzer, info.Message),
+			RuleID:     ruleID,
+			Pos:        parsePosn(info.Posn),
+			Pkg:        pkg,
+		})
+	}
+
+	return issues
+}
+
+// parsePosn parses a position formatted by [token.Position.String] (`file:line:column` or `file:line`).
+func parsePosn(posn string) token.Position {
+	var numbers []int
+
+	for range 2 {
+		i := strings.LastIndexByte(posn, ':')
+		if i < 0 {
+			break
+		}
+
+		n, err := strconv.Atoi(posn[i+1:])
+		if err != nil {
+			break
+		}
+
+		numbers = append([]int{n}, numbers...)
+		posn = posn[:i]
+	}
+
+	position := token.Position{Filename: posn}
+
+	switch len(numbers) {
+	case 1:
+		position.Line = numbers[0]
+	case 2:
+		position.Line, position.Column = numbers[0], numbers[1]
+	}
+
+	return position
+}
+
+// isStandard reports whether a package belongs to the standard library:
+// the first element of its path doesn't contain a dot, and it doesn't belong to a module.
+func isStandard(pkg *packages.Package) bool {
+	if pkg.Module != nil {
+		return false
+	}
+
+	first, _, _ := strings.Cut(pkg.PkgPath, "/")
+
+	return !strings.Contains(first, ".")
+}
@@ -0,0 +1,199 @@
+package external
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+const vetToolHelperEnv = "GOLANGCI_LINT_VETTOOL_HELPER"
+
+// TestVetToolHelperProcess is the unitchecker binary run by the tests:
+// it reports the facts it has received, and writes its own facts.
+func TestVetToolHelperProcess(_ *testing.T) {
+	if os.Getenv(vetToolHelperEnv) == "" {
+		return
+	}
+
+	data, err := os.ReadFile(os.Args[len(os.Args)-1])
+	if err != nil {
+		panic(err)
+	}
+
+	var cfg vetConfig
+
+	err = json.Unmarshal(data, &cfg)
+	if err != nil {
+		panic(err)
+	}
+
+	err = os.WriteFile(cfg.VetxOutput, []byte(cfg.ImportPath), 0o600)
+	if err != nil {
+		panic(err)
+	}
+
+	if cfg.VetxOnly {
+		os.Exit(0)
+	}
+
+	var diags []map[string]string
+	for path, vetx := range cfg.PackageVetx {
+		facts, errR := os.ReadFile(vetx)
+		if errR != nil {
+			panic(errR)
+		}
+
+		diags = append(diags, map[string]string{
+			"posn":    cfg.GoFiles[0] + ":1:1",
+			"message": fmt.Sprintf("facts of %s: %s", path, facts),
+		})
+	}
+
+	tree := map[string]map[string]any{cfg.ID: {"facts": diags}}
+
+	err = json.NewEncoder(os.Stdout).Encode(tree)
+	if err != nil {
+		panic(err)
+	}
+
+	os.Exit(0)
+}
+
+func TestVetTool_Run(t *testing.T) {
+	t.Setenv(vetToolHelperEnv, "1")
+
+	dir := t.TempDir()
+
+	std := &packages.Package{ID: "fmt", PkgPath: "fmt", ExportFile: "fmt.a"}
+	lib := &packages.Package{
+		ID:         "example.com/lib",
+		PkgPath:    "example.com/lib",
+		GoFiles:    []string{filepath.Join(dir, "lib", "lib.go")},
+		ExportFile: "lib.a",
+		Imports:    map[string]*packages.Package{"fmt": std},
+		Module:     &packages.Module{Path: "example.com"},
+	}
+	pkg := &packages.Package{
+		ID:              "example.com/app",
+		PkgPath:         "example.com/app",
+		GoFiles:         []string{filepath.Join(dir, "app.go")},
+		CompiledGoFiles: []string{filepath.Join(dir, "app.go")},
+		Imports:         map[string]*packages.Package{"example.com/lib": lib},
+		Module:          &packages.Module{Path: "example.com"},
+	}
+
+	v := NewVetTool("example", &config.CustomLinterSettings{
+		Type:    "vettool",
+		Command: os.Args[0],
+		Args:    []string{"-test.run=TestVetToolHelperProcess", "--"},
+	}, "")
+
+	issues, err := v.Run(context.Background(), &linter.Context{
+		Packages: []*packages.Package{pkg},
+		Log:      logutils.NewStderrLog(logutils.DebugKeyEmpty),
+	})
+	require.NoError(t, err)
+
+	// The facts of the standard library are not computed.
+	expected := []result.Issue{{
+		FromLinter: "example",
+		Text:       "facts: facts of example.com/lib: example.com/lib",
+		RuleID:     "facts",
+		Pos:        token.Position{Filename: filepath.Join(dir, "app.go"), Line: 1, Column: 1},
+		Pkg:        pkg,
+	}}
+
+	assert.Equal(t, expected, issues)
+}
+
+func TestVetRun_parse(t *testing.T) {
+	r := &vetRun{
+		tool: &VetTool{name: "example", command: "example"},
+		log:  logutils.NewStderrLog(logutils.DebugKeyEmpty),
+	}
+
+	pkg := &packages.Package{ID: "example.com/app"}
+
+	data := `{
+	"example.com/app": {
+		"example": [
+			{
+				"posn": "/app/app.go:10:4",
+				"message": "some issue",
+				"suggested_fixes": [{"message": "fix it", "edits": [{"filename": "/app/app.go", "start": 12, "end": 15, "new": "foo"}]}],
+				"related": [{"posn": "/app/app.go:3:2", "message": "declared here"}]
+			}
+		],
+		"failing": {"error": "something went wrong"}
+	}
+}`
+
+	issues, err := r.parse(pkg, []byte(data))
+	require.NoError(t, err)
+
+	expected := []result.Issue{
+		{
+			FromLinter: "example",
+			Text:       "some issue",
+			Pos:        token.Position{Filename: "/app/app.go", Line: 10, Column: 4},
+			Pkg:        pkg,
+			SuggestedFixes: []result.SuggestedFix{{
+				Message:   "fix it",
+				TextEdits: []result.TextEdit{{Filename: "/app/app.go", Start: 12, End: 15, NewText: "foo"}},
+			}},
+		},
+		{
+			FromLinter: "example",
+			Text:       "example(related information): declared here",
+			Pos:        token.Position{Filename: "/app/app.go", Line: 3, Column: 2},
+			Pkg:        pkg,
+		},
+	}
+
+	assert.Equal(t, expected, issues)
+}
+
+func Test_parsePosn(t *testing.T) {
+	testCases := []struct {
+		posn     string
+		expected token.Position
+	}{
+		{
+			posn:     "/app/app.go:10:4",
+			expected: token.Position{Filename: "/app/app.go", Line: 10, Column: 4},
+		},
+		{
+			posn:     "/app/app.go:10",
+			expected: token.Position{Filename: "/app/app.go", Line: 10},
+		},
+		{
+			posn:     `C:\app\app.go:10:4`,
+			expected: token.Position{Filename: `C:\app\app.go`, Line: 10, Column: 4},
+		},
+		{
+			posn:     "-",
+			expected: token.Position{Filename: "-"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.posn, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, parsePosn(test.posn))
+		})
+	}
+}
@@ -42,6 +42,12 @@ type Deprecation struct {
 	Level       DeprecationLevel
 }
 
+// AnalyzerDoc is the documentation of an analyzer of a linter.
+type AnalyzerDoc struct {
+	Name string
+	Doc  string
+}
+
 type Config struct {
 	Linter           Linter
 	EnabledByDefault bool
@@ -59,6 +65,9 @@ type Config struct {
 
 	Since       string
 	Deprecation *Deprecation
+
+	// AnalyzersDocs are displayed by the help of the linters (only for the custom linters).
+	AnalyzersDocs []AnalyzerDoc
 }
 
 func (lc *Config) WithEnabledByDefault() *Config {
@@ -112,6 +121,11 @@ func (lc *Config) WithAutoFix() *Config {
 	return lc
 }
 
+func (lc *Config) WithAnalyzersDocs(docs ...AnalyzerDoc) *Config {
+	lc.AnalyzersDocs = docs
+	return lc
+}
+
 func (lc *Config) WithChangeTypes() *Config {
 	lc.DoesChangeTypes = true
 	return lc
@@ -9,6 +9,7 @@ import (
 	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/fsutils"
 	"github.com/golangci/golangci-lint/pkg/goanalysis/load"
+	"github.com/golangci/golangci-lint/pkg/goanalysis/profile"
 	"github.com/golangci/golangci-lint/pkg/logutils"
 )
 
@@ -26,6 +27,15 @@ type Context struct {
 
 	PkgCache  *pkgcache.Cache
 	LoadGuard *load.Guard
+
+	// Overlay contains the content of the files that differ from the disk (absolute path -> content).
+	Overlay map[string][]byte
+
+	// Profiler measures the cost of the analyzers (optional).
+	Profiler *profile.Profiler
+
+	// RunCache is shared by the contexts of the scopes of a run (optional).
+	RunCache *RunCache
 }
 
 func (c *Context) Settings() *config.LintersSettings {
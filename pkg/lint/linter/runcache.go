@@ -0,0 +1,45 @@
+package linter
+
+import "sync"
+
+// RunCache stores the results computed once per run.
+// It is shared by the contexts of the scopes of the overrides (the linters of each scope are distinct instances).
+type RunCache struct {
+	mu     sync.Mutex
+	values map[string]*runValue
+}
+
+type runValue struct {
+	once  sync.Once
+	value any
+	err   error
+}
+
+// NewRunCache creates an empty RunCache.
+func NewRunCache() *RunCache {
+	return &RunCache{values: map[string]*runValue{}}
+}
+
+// Do returns the result of the first call of fn for the key.
+// A nil cache calls fn every time.
+func (c *RunCache) Do(key string, fn func() (any, error)) (any, error) {
+	if c == nil {
+		return fn()
+	}
+
+	c.mu.Lock()
+
+	v, ok := c.values[key]
+	if !ok {
+		v = &runValue{}
+		c.values[key] = v
+	}
+
+	c.mu.Unlock()
+
+	v.once.Do(func() {
+		v.value, v.err = fn()
+	})
+
+	return v.value, v.err
+}
@@ -0,0 +1,52 @@
+package lintersdb
+
+import (
+	"fmt"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint/external"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+const commandPluginType = "command"
+
+// PluginCommandBuilder builds the custom linters (external commands) based on the configuration.
+type PluginCommandBuilder struct {
+	log logutils.Log
+}
+
+// NewPluginCommandBuilder creates new PluginCommandBuilder.
+func NewPluginCommandBuilder(log logutils.Log) *PluginCommandBuilder {
+	return &PluginCommandBuilder{log: log}
+}
+
+// Build creates the custom linters running the commands specified in the golangci-lint config file.
+func (b *PluginCommandBuilder) Build(cfg *config.Config) ([]*linter.Config, error) {
+	if cfg == nil || b.log == nil {
+		return nil, nil
+	}
+
+	var linters []*linter.Config
+
+	for name := range cfg.LintersSettings.Custom {
+		settings := cfg.LintersSettings.Custom[name]
+
+		if settings.Type != commandPluginType {
+			continue
+		}
+
+		customLinter, err := external.NewLinter(name, &settings, cfg.GetConfigDir())
+		if err != nil {
+			return nil, fmt.Errorf("command(%s): %w", name, err)
+		}
+
+		b.log.Infof("Loaded %s: %s", settings.Command, name)
+
+		linters = append(linters, linter.NewConfig(customLinter).
+			WithEnabledByDefault().
+			WithURL(settings.OriginalURL))
+	}
+
+	return linters, nil
+}
@@ -38,7 +38,9 @@ func (b *PluginGoBuilder) Build(cfg *config.Config) ([]*linter.Config, error) {
 
 	var linters []*linter.Config
 
-	for name, settings := range cfg.LintersSettings.Custom {
+	for name := range cfg.LintersSettings.Custom {
+		settings := cfg.LintersSettings.Custom[name]
+
 		if settings.Type != goPluginType && settings.Type != "" {
 			continue
 		}
@@ -1,10 +1,14 @@
 package lintersdb
 
 import (
+	"bytes"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/golangci/plugin-module-register/register"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"golang.org/x/tools/go/analysis"
 
 	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/goanalysis"
@@ -14,6 +18,29 @@ import (
 
 const modulePluginType = "module"
 
+// LinterPluginV2 is the extended interface of the module plugins.
+//
+// The methods only use the types of the standard library:
+// a plugin implements the interface without importing golangci-lint.
+type LinterPluginV2 interface {
+	register.LinterPlugin
+
+	// GetPresets returns the presets of the linter (ex: `bugs`, `style`).
+	GetPresets() []string
+
+	// IsSlow returns true if the linter must be disabled by the `--fast` flag.
+	IsSlow() bool
+
+	// CanAutoFix returns true if the analyzers provide suggested fixes.
+	CanAutoFix() bool
+
+	// GetSettingsSchema returns the JSON schema of the settings, or nil.
+	// The schema is read from a plugin created without settings (nil):
+	// the settings are validated against the schema before the plugin is created with them.
+	// The settings of a plugin that can't be created without settings are validated after its creation.
+	GetSettingsSchema() []byte
+}
+
 // PluginModuleBuilder builds the custom linters (module plugin) based on the configuration.
 type PluginModuleBuilder struct {
 	log logutils.Log
@@ -32,54 +59,192 @@ func (b *PluginModuleBuilder) Build(cfg *config.Config) ([]*linter.Config, error
 
 	var linters []*linter.Config
 
-	for name, settings := range cfg.LintersSettings.Custom {
+	for name := range cfg.LintersSettings.Custom {
+		settings := cfg.LintersSettings.Custom[name]
+
 		if settings.Type != modulePluginType {
 			continue
 		}
 
 		b.log.Infof("Loaded %s: %s", settings.Path, name)
 
-		newPlugin, err := register.GetPlugin(name)
+		lc, err := buildModulePlugin(name, &settings)
 		if err != nil {
 			return nil, fmt.Errorf("plugin(%s): %w", name, err)
 		}
 
-		p, err := newPlugin(settings.Settings)
+		linters = append(linters, lc)
+	}
+
+	return linters, nil
+}
+
+// SettingsSchemas returns the JSON schemas of the settings of the plugins implementing LinterPluginV2.
+// The schemas of the plugins that can't be created without settings are unknown.
+func (b *PluginModuleBuilder) SettingsSchemas(cfg *config.Config) (map[string][]byte, error) {
+	if cfg == nil || b.log == nil {
+		return nil, nil
+	}
+
+	schemas := map[string][]byte{}
+
+	for name := range cfg.LintersSettings.Custom {
+		settings := cfg.LintersSettings.Custom[name]
+
+		if settings.Type != modulePluginType {
+			continue
+		}
+
+		newPlugin, err := register.GetPlugin(name)
 		if err != nil {
-			return nil, fmt.Errorf("plugin(%s): newPlugin %w", name, err)
+			return nil, fmt.Errorf("plugin(%s): %w", name, err)
 		}
 
-		analyzers, err := p.BuildAnalyzers()
+		// The plugins aren't created with the settings of the configuration: the settings can be invalid.
+		if schema, ok := settingsSchema(newPlugin); ok && len(schema) > 0 {
+			schemas[name] = schema
+		}
+	}
+
+	return schemas, nil
+}
+
+func buildModulePlugin(name string, settings *config.CustomLinterSettings) (*linter.Config, error) {
+	newPlugin, err := register.GetPlugin(name)
+	if err != nil {
+		return nil, err
+	}
+
+	// The settings are validated before the creation of the plugin: the plugin can fail to decode invalid settings.
+	schema, validated := settingsSchema(newPlugin)
+	if validated {
+		err = validateSettings(name, schema, settings.Settings)
 		if err != nil {
-			return nil, fmt.Errorf("plugin(%s): BuildAnalyzers %w", name, err)
+			return nil, err
 		}
+	}
 
-		customLinter := goanalysis.NewLinter(name, settings.Description, analyzers, nil)
+	p, err := newPlugin(settings.Settings)
+	if err != nil {
+		return nil, fmt.Errorf("newPlugin %w", err)
+	}
 
-		switch strings.ToLower(p.GetLoadMode()) {
-		case register.LoadModeSyntax:
-			customLinter = customLinter.WithLoadMode(goanalysis.LoadModeSyntax)
-		case register.LoadModeTypesInfo:
-			customLinter = customLinter.WithLoadMode(goanalysis.LoadModeTypesInfo)
-		default:
-			customLinter = customLinter.WithLoadMode(goanalysis.LoadModeTypesInfo)
+	v2, isV2 := p.(LinterPluginV2)
+	if isV2 && !validated {
+		err = validateSettings(name, v2.GetSettingsSchema(), settings.Settings)
+		if err != nil {
+			return nil, err
 		}
+	}
+
+	analyzers, err := p.BuildAnalyzers()
+	if err != nil {
+		return nil, fmt.Errorf("BuildAnalyzers %w", err)
+	}
+
+	customLinter := goanalysis.NewLinter(name, settings.Description, analyzers, nil)
+
+	switch strings.ToLower(p.GetLoadMode()) {
+	case register.LoadModeSyntax:
+		customLinter = customLinter.WithLoadMode(goanalysis.LoadModeSyntax)
+	case register.LoadModeTypesInfo:
+		customLinter = customLinter.WithLoadMode(goanalysis.LoadModeTypesInfo)
+	default:
+		customLinter = customLinter.WithLoadMode(goanalysis.LoadModeTypesInfo)
+	}
+
+	lc := linter.NewConfig(customLinter).
+		WithEnabledByDefault().
+		WithURL(settings.OriginalURL)
+
+	switch strings.ToLower(p.GetLoadMode()) {
+	case register.LoadModeSyntax:
+		// noop
+	case register.LoadModeTypesInfo:
+		lc = lc.WithLoadForGoAnalysis()
+	default:
+		lc = lc.WithLoadForGoAnalysis()
+	}
+
+	if !isV2 {
+		return lc, nil
+	}
+
+	return configureV2(lc, v2, analyzers)
+}
 
-		lc := linter.NewConfig(customLinter).
-			WithEnabledByDefault().
-			WithURL(settings.OriginalURL)
-
-		switch strings.ToLower(p.GetLoadMode()) {
-		case register.LoadModeSyntax:
-			// noop
-		case register.LoadModeTypesInfo:
-			lc = lc.WithLoadForGoAnalysis()
-		default:
-			lc = lc.WithLoadForGoAnalysis()
+func configureV2(lc *linter.Config, p LinterPluginV2, analyzers []*analysis.Analyzer) (*linter.Config, error) {
+	presets := p.GetPresets()
+
+	for _, preset := range presets {
+		if !slices.Contains(AllPresets(), preset) {
+			return nil, fmt.Errorf("unknown preset %q", preset)
 		}
+	}
 
-		linters = append(linters, lc)
+	lc = lc.WithPresets(presets...)
+
+	// The load mode marks the linters using the types as slow: the plugin has the final say.
+	lc.IsSlow = p.IsSlow()
+
+	if p.CanAutoFix() {
+		lc = lc.WithAutoFix()
 	}
 
-	return linters, nil
+	var docs []linter.AnalyzerDoc
+	for _, analyzer := range analyzers {
+		docs = append(docs, linter.AnalyzerDoc{Name: analyzer.Name, Doc: analyzer.Doc})
+	}
+
+	return lc.WithAnalyzersDocs(docs...), nil
+}
+
+// settingsSchema returns the JSON schema of the settings of a plugin created without settings.
+// It returns false if the plugin can't be created without settings (e.g. required settings).
+func settingsSchema(newPlugin register.NewPlugin) ([]byte, bool) {
+	p, err := newPlugin(nil)
+	if err != nil {
+		return nil, false
+	}
+
+	v2, ok := p.(LinterPluginV2)
+	if !ok {
+		return nil, true
+	}
+
+	return v2.GetSettingsSchema(), true
+}
+
+// validateSettings validates the settings of a plugin against the JSON schema of the plugin.
+func validateSettings(name string, schema []byte, settings any) error {
+	if len(schema) == 0 {
+		return nil
+	}
+
+	// The URL is only used to identify the schema inside the errors.
+	schemaURL := "plugin://" + name + "/settings.jsonschema.json"
+
+	compiler := jsonschema.NewCompiler()
+
+	err := compiler.AddResource(schemaURL, bytes.NewReader(schema))
+	if err != nil {
+		return fmt.Errorf("settings JSON schema: %w", err)
+	}
+
+	s, err := compiler.Compile(schemaURL)
+	if err != nil {
+		return fmt.Errorf("settings JSON schema: %w", err)
+	}
+
+	// Without settings, the default values of the plugin are used: they are validated as an empty object.
+	if settings == nil {
+		settings = map[string]any{}
+	}
+
+	err = s.Validate(settings)
+	if err != nil {
+		return fmt.Errorf("invalid settings: %w", err)
+	}
+
+	return nil
 }
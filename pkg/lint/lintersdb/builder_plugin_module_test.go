@@ -0,0 +1,258 @@
+package lintersdb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golangci/plugin-module-register/register"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+const exampleSettingsSchema = `{
+	"type": "object",
+	"additionalProperties": false,
+	"properties": {
+		"message": {"type": "string"}
+	}
+}`
+
+type examplePlugin struct{}
+
+func (examplePlugin) BuildAnalyzers() ([]*analysis.Analyzer, error) {
+	return []*analysis.Analyzer{
+		{Name: "foo", Doc: "Reports foo.\n\nMore details."},
+		{Name: "bar", Doc: "Reports bar."},
+	}, nil
+}
+
+func (examplePlugin) GetLoadMode() string {
+	return register.LoadModeTypesInfo
+}
+
+type examplePluginV2 struct {
+	examplePlugin
+
+	presets []string
+}
+
+func (p examplePluginV2) GetPresets() []string {
+	return p.presets
+}
+
+func (examplePluginV2) IsSlow() bool {
+	return false
+}
+
+func (examplePluginV2) CanAutoFix() bool {
+	return true
+}
+
+func (examplePluginV2) GetSettingsSchema() []byte {
+	return []byte(exampleSettingsSchema)
+}
+
+// registerExamplePlugins registers the plugins of the tests (the registration of a plugin is idempotent).
+func registerExamplePlugins() {
+	register.Plugin("example-v1", func(any) (register.LinterPlugin, error) {
+		return examplePlugin{}, nil
+	})
+
+	register.Plugin("example-v2", func(any) (register.LinterPlugin, error) {
+		return examplePluginV2{presets: []string{linter.PresetStyle, linter.PresetBugs}}, nil
+	})
+
+	register.Plugin("example-v2-unknown-preset", func(any) (register.LinterPlugin, error) {
+		return examplePluginV2{presets: []string{"unknown"}}, nil
+	})
+
+	// The plugin fails to decode invalid settings.
+	register.Plugin("example-v2-strict", func(conf any) (register.LinterPlugin, error) {
+		settings, _ := conf.(map[string]any)
+
+		if _, ok := settings["message"].(string); !ok && settings["message"] != nil {
+			return nil, errors.New("can't decode the settings")
+		}
+
+		return examplePluginV2{}, nil
+	})
+
+	// The plugin can't be created without settings.
+	register.Plugin("example-v2-required", func(conf any) (register.LinterPlugin, error) {
+		if conf == nil {
+			return nil, errors.New("settings required")
+		}
+
+		return examplePluginV2{}, nil
+	})
+}
+
+func TestPluginModuleBuilder_Build(t *testing.T) {
+	registerExamplePlugins()
+
+	testCases := []struct {
+		desc     string
+		name     string
+		settings any
+		check    func(t *testing.T, lc *linter.Config)
+	}{
+		{
+			desc: "v1",
+			name: "example-v1",
+			check: func(t *testing.T, lc *linter.Config) {
+				t.Helper()
+
+				assert.True(t, lc.EnabledByDefault)
+				assert.True(t, lc.IsSlowLinter())
+				assert.False(t, lc.CanAutoFix)
+				assert.Empty(t, lc.InPresets)
+				assert.Empty(t, lc.AnalyzersDocs)
+			},
+		},
+		{
+			desc:     "v2",
+			name:     "example-v2",
+			settings: map[string]any{"message": "hello"},
+			check: func(t *testing.T, lc *linter.Config) {
+				t.Helper()
+
+				assert.True(t, lc.EnabledByDefault)
+				assert.False(t, lc.IsSlowLinter())
+				assert.True(t, lc.CanAutoFix)
+				assert.Equal(t, []string{linter.PresetStyle, linter.PresetBugs}, lc.InPresets)
+
+				expected := []linter.AnalyzerDoc{
+					{Name: "foo", Doc: "Reports foo.\n\nMore details."},
+					{Name: "bar", Doc: "Reports bar."},
+				}
+
+				assert.Equal(t, expected, lc.AnalyzersDocs)
+			},
+		},
+		{
+			desc: "v2 without settings",
+			name: "example-v2",
+			check: func(t *testing.T, lc *linter.Config) {
+				t.Helper()
+
+				assert.Equal(t, "example-v2", lc.Name())
+			},
+		},
+		{
+			desc:     "v2 with required settings",
+			name:     "example-v2-required",
+			settings: map[string]any{"message": "hello"},
+			check: func(t *testing.T, lc *linter.Config) {
+				t.Helper()
+
+				assert.Equal(t, "example-v2-required", lc.Name())
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := config.NewDefault()
+			cfg.LintersSettings.Custom = map[string]config.CustomLinterSettings{
+				test.name: {Type: "module", Settings: test.settings},
+			}
+
+			linters, err := NewPluginModuleBuilder(logutils.NewStderrLog("skip")).Build(cfg)
+			require.NoError(t, err)
+
+			require.Len(t, linters, 1)
+
+			test.check(t, linters[0])
+		})
+	}
+}
+
+func TestPluginModuleBuilder_Build_error(t *testing.T) {
+	registerExamplePlugins()
+
+	testCases := []struct {
+		desc     string
+		name     string
+		settings any
+		expected string
+	}{
+		{
+			desc:     "unknown plugin",
+			name:     "example-unknown",
+			expected: `plugin(example-unknown): plugin "example-unknown" not found`,
+		},
+		{
+			desc:     "invalid settings",
+			name:     "example-v2",
+			settings: map[string]any{"message": 1},
+			expected: "plugin(example-v2): invalid settings: jsonschema: '/message' does not validate with " +
+				"plugin://example-v2/settings.jsonschema.json#/properties/message/type: expected string, but got number",
+		},
+		{
+			desc:     "invalid settings validated before the creation",
+			name:     "example-v2-strict",
+			settings: map[string]any{"message": 1},
+			expected: "plugin(example-v2-strict): invalid settings: jsonschema: '/message' does not validate with " +
+				"plugin://example-v2-strict/settings.jsonschema.json#/properties/message/type: expected string, but got number",
+		},
+		{
+			desc:     "invalid required settings",
+			name:     "example-v2-required",
+			settings: map[string]any{"message": 1},
+			expected: "plugin(example-v2-required): invalid settings: jsonschema: '/message' does not validate with " +
+				"plugin://example-v2-required/settings.jsonschema.json#/properties/message/type: expected string, but got number",
+		},
+		{
+			desc:     "unknown preset",
+			name:     "example-v2-unknown-preset",
+			expected: `plugin(example-v2-unknown-preset): unknown preset "unknown"`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := config.NewDefault()
+			cfg.LintersSettings.Custom = map[string]config.CustomLinterSettings{
+				test.name: {Type: "module", Settings: test.settings},
+			}
+
+			_, err := NewPluginModuleBuilder(logutils.NewStderrLog("skip")).Build(cfg)
+
+			assert.EqualError(t, err, test.expected)
+		})
+	}
+}
+
+func TestPluginModuleBuilder_SettingsSchemas(t *testing.T) {
+	registerExamplePlugins()
+
+	cfg := config.NewDefault()
+	cfg.LintersSettings.Custom = map[string]config.CustomLinterSettings{
+		"example-v1": {Type: "module"},
+		"example-v2": {Type: "module"},
+		// The schema is known even if the plugin can't be created with the settings.
+		"example-v2-strict": {Type: "module", Settings: map[string]any{"message": 1}},
+		// The schema is unknown: the plugin can't be created without settings.
+		"example-v2-required": {Type: "module", Settings: map[string]any{"message": "hello"}},
+		"other":               {Type: "command", Command: "other"},
+	}
+
+	schemas, err := NewPluginModuleBuilder(logutils.NewStderrLog("skip")).SettingsSchemas(cfg)
+	require.NoError(t, err)
+
+	expected := map[string][]byte{
+		"example-v2":        []byte(exampleSettingsSchema),
+		"example-v2-strict": []byte(exampleSettingsSchema),
+	}
+
+	assert.Equal(t, expected, schemas)
+}
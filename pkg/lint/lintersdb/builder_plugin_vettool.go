@@ -0,0 +1,53 @@
+package lintersdb
+
+import (
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint/external"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+const vetToolPluginType = "vettool"
+
+// PluginVetToolBuilder builds the custom linters (unitchecker binaries) based on the configuration.
+type PluginVetToolBuilder struct {
+	log logutils.Log
+}
+
+// NewPluginVetToolBuilder creates new PluginVetToolBuilder.
+func NewPluginVetToolBuilder(log logutils.Log) *PluginVetToolBuilder {
+	return &PluginVetToolBuilder{log: log}
+}
+
+// Build creates the custom linters running the `go vet -vettool` binaries specified in the golangci-lint config file.
+func (b *PluginVetToolBuilder) Build(cfg *config.Config) ([]*linter.Config, error) {
+	if cfg == nil || b.log == nil {
+		return nil, nil
+	}
+
+	var linters []*linter.Config
+
+	for name := range cfg.LintersSettings.Custom {
+		settings := cfg.LintersSettings.Custom[name]
+
+		if settings.Type != vetToolPluginType {
+			continue
+		}
+
+		b.log.Infof("Loaded %s: %s", settings.Command, name)
+
+		lc := linter.NewConfig(external.NewVetTool(name, &settings, cfg.GetConfigDir())).
+			WithEnabledByDefault().
+			WithLoadForGoAnalysis().
+			WithURL(settings.OriginalURL)
+
+		// The binary needs the export data of the dependencies, and the Go version of the module.
+		lc.LoadMode |= packages.NeedModule
+
+		linters = append(linters, lc)
+	}
+
+	return linters, nil
+}
@@ -3,6 +3,7 @@ package lintersdb
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"slices"
 	"sort"
 
@@ -29,6 +30,18 @@ type Manager struct {
 	linters []*linter.Config
 
 	nameToLCs map[string][]*linter.Config
+
+	// overrides are the managers of the linters of the overrides of the configuration (see config.Override).
+	overrides []*Manager
+}
+
+// Scope is the linters of the packages under the directories of an override of the configuration (see config.Override).
+type Scope struct {
+	// Dirs are the absolute paths of the directories of the packages.
+	Dirs []string
+
+	Cfg     *config.Config
+	Linters []*linter.Config
 }
 
 // NewManager creates a new Manager.
@@ -65,6 +78,21 @@ func NewManager(log logutils.Log, cfg *config.Config, builders ...Builder) (*Man
 		return nil, err
 	}
 
+	// The linters of an override are built with the settings of the override.
+	for i := range m.cfg.Overrides {
+		cfg := m.cfg.Overrides[i].Config()
+		if cfg == nil {
+			return nil, fmt.Errorf("override #%d: the configuration is not loaded", i)
+		}
+
+		om, err := NewManager(log, cfg, builders...)
+		if err != nil {
+			return nil, fmt.Errorf("override #%d: %w", i, err)
+		}
+
+		m.overrides = append(m.overrides, om)
+	}
+
 	return m, nil
 }
 
@@ -91,9 +119,18 @@ func (m *Manager) GetAllLinterConfigsForPreset(p string) []*linter.Config {
 	return ret
 }
 
+// GetEnabledLintersMap returns the linters enabled by the configuration, or by one of its overrides.
 func (m *Manager) GetEnabledLintersMap() (map[string]*linter.Config, error) {
 	enabledLinters := m.build(m.GetAllEnabledByDefaultLinters())
 
+	for _, om := range m.overrides {
+		for name, lc := range om.build(om.GetAllEnabledByDefaultLinters()) {
+			if _, ok := enabledLinters[name]; !ok {
+				enabledLinters[name] = lc
+			}
+		}
+	}
+
 	if os.Getenv(logutils.EnvTestRun) == "1" {
 		m.verbosePrintLintersStatus(enabledLinters)
 	}
@@ -107,6 +144,57 @@ func (m *Manager) GetOptimizedLinters() ([]*linter.Config, error) {
 	resultLintersSet := m.build(m.GetAllEnabledByDefaultLinters())
 	m.verbosePrintLintersStatus(resultLintersSet)
 
+	return m.optimize(resultLintersSet), nil
+}
+
+// GetOptimizedScopes returns the optimized linters of the overrides of the configuration (see GetOptimizedLinters).
+// The directories are relative to the working directory.
+func (m *Manager) GetOptimizedScopes() ([]Scope, error) {
+	var scopes []Scope
+
+	for i, om := range m.overrides {
+		var dirs []string
+
+		for _, path := range m.cfg.Overrides[i].Paths {
+			dir, err := filepath.Abs(path)
+			if err != nil {
+				return nil, err
+			}
+
+			dirs = append(dirs, dir)
+		}
+
+		linters := om.build(om.GetAllEnabledByDefaultLinters())
+
+		names := maps.Keys(linters)
+		sort.Strings(names)
+
+		m.debugf("Linters of the override #%d %v: %v", i, m.cfg.Overrides[i].Paths, names)
+
+		scopes = append(scopes, Scope{Dirs: dirs, Cfg: om.cfg, Linters: om.optimize(linters)})
+	}
+
+	return scopes, nil
+}
+
+// GetLintersToLoad returns the optimized linters of the configuration, and of its overrides:
+// the packages are loaded for all of them.
+func (m *Manager) GetLintersToLoad() ([]*linter.Config, error) {
+	linters := m.optimize(m.build(m.GetAllEnabledByDefaultLinters()))
+
+	scopes, err := m.GetOptimizedScopes()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, scope := range scopes {
+		linters = append(linters, scope.Linters...)
+	}
+
+	return linters, nil
+}
+
+func (m *Manager) optimize(resultLintersSet map[string]*linter.Config) []*linter.Config {
 	m.combineGoAnalysisLinters(resultLintersSet)
 
 	resultLinters := maps.Values(resultLintersSet)
@@ -129,7 +217,7 @@ func (m *Manager) GetOptimizedLinters() ([]*linter.Config, error) {
 		return a.Name() < b.Name()
 	})
 
-	return resultLinters, nil
+	return resultLinters
 }
 
 func (m *Manager) GetAllEnabledByDefaultLinters() []*linter.Config {
@@ -0,0 +1,213 @@
+package lint
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"golang.org/x/mod/modfile"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/goutil"
+)
+
+// Module is a module analyzed by a run with several modules.
+type Module struct {
+	Path string // The module path.
+	Dir  string // The absolute path of the directory of the module.
+
+	// Args are the patterns of the analyzed packages, relative to the directory of the module.
+	Args []string
+}
+
+// FindModules returns the modules analyzed by a run: the modules of `run.modules`, or the modules of the go.work file,
+// matching the arguments.
+// It returns nil when only the module of the working directory is analyzed: the packages are loaded as usual.
+func FindModules(cfg *config.Config, goenv *goutil.Env, args []string) ([]Module, error) {
+	dirs, err := findModuleDirs(cfg, goenv)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(dirs) == 0 {
+		return nil, nil
+	}
+
+	var modules []Module
+
+	for _, dir := range dirs {
+		path, errR := readModulePath(dir)
+		if errR != nil {
+			return nil, errR
+		}
+
+		modules = append(modules, Module{Path: path, Dir: dir})
+	}
+
+	modules, err = matchModules(modules, buildArgs(args))
+	if err != nil {
+		return nil, err
+	}
+
+	wd, err := fsutils.Getwd()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(modules) == 0 || len(modules) == 1 && isWithin(wd, modules[0].Dir) {
+		return nil, nil
+	}
+
+	return modules, nil
+}
+
+// findModuleDirs returns the absolute paths of the directories of the modules of `run.modules`,
+// or of the modules used by the go.work file.
+func findModuleDirs(cfg *config.Config, goenv *goutil.Env) ([]string, error) {
+	if len(cfg.Run.Modules) > 0 {
+		var dirs []string
+
+		for _, dir := range cfg.Run.Modules {
+			abs, err := filepath.Abs(dir)
+			if err != nil {
+				return nil, err
+			}
+
+			dirs = append(dirs, abs)
+		}
+
+		return dirs, nil
+	}
+
+	goWork := goenv.Get(goutil.EnvGoWork)
+	if goWork == "" || goWork == "off" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(goWork)
+	if err != nil {
+		return nil, fmt.Errorf("can't read the workspace file: %w", err)
+	}
+
+	file, err := modfile.ParseWork(goWork, data, nil)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse the workspace file: %w", err)
+	}
+
+	var dirs []string
+
+	for _, use := range file.Use {
+		dir := filepath.FromSlash(use.Path)
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(filepath.Dir(goWork), dir)
+		}
+
+		dirs = append(dirs, dir)
+	}
+
+	return dirs, nil
+}
+
+func readModulePath(dir string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(dir, "go.mod"))
+	if err != nil {
+		return "", fmt.Errorf("can't read the module of %s: %w", dir, err)
+	}
+
+	path := modfile.ModulePath(data)
+	if path == "" {
+		return "", fmt.Errorf("no module path in %s", filepath.Join(dir, "go.mod"))
+	}
+
+	return path, nil
+}
+
+// matchModules returns the modules matching the arguments, with the patterns of their packages.
+// A directory belongs to the innermost module containing it, and a recursive pattern (`dir/...`) matches the modules inside the directory.
+func matchModules(modules []Module, args []string) ([]Module, error) {
+	patterns := map[string][]string{}
+
+	add := func(mod *Module, pattern string) {
+		if !slices.Contains(patterns[mod.Dir], pattern) {
+			patterns[mod.Dir] = append(patterns[mod.Dir], pattern)
+		}
+	}
+
+	for _, arg := range args {
+		path, recursive := strings.CutSuffix(arg, "...")
+
+		abs, err := filepath.Abs(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if mod := findInnermostModule(modules, abs); mod != nil {
+			rel, err := filepath.Rel(mod.Dir, abs)
+			if err != nil {
+				return nil, err
+			}
+
+			pattern := "./" + filepath.ToSlash(rel)
+			if rel == "." {
+				pattern = "."
+			}
+
+			if recursive {
+				pattern += "/..."
+			}
+
+			add(mod, pattern)
+		}
+
+		if !recursive {
+			continue
+		}
+
+		for i := range modules {
+			if modules[i].Dir != abs && isWithin(modules[i].Dir, abs) {
+				add(&modules[i], "./...")
+			}
+		}
+	}
+
+	var matched []Module
+
+	for _, mod := range modules {
+		if len(patterns[mod.Dir]) == 0 {
+			continue
+		}
+
+		mod.Args = patterns[mod.Dir]
+
+		matched = append(matched, mod)
+	}
+
+	return matched, nil
+}
+
+func findInnermostModule(modules []Module, path string) *Module {
+	var found *Module
+
+	for i := range modules {
+		mod := &modules[i]
+
+		if isWithin(path, mod.Dir) && (found == nil || len(mod.Dir) > len(found.Dir)) {
+			found = mod
+		}
+	}
+
+	return found
+}
+
+// isWithin returns true if the path is the directory, or is inside it.
+func isWithin(path, dir string) bool {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false
+	}
+
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
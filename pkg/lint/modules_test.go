@@ -0,0 +1,152 @@
+package lint
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/goutil"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+func Test_matchModules(t *testing.T) {
+	root := mustAbs(t, "/tmp/ws")
+
+	modules := []Module{
+		{Path: "example.com/a", Dir: filepath.Join(root, "a")},
+		{Path: "example.com/b", Dir: filepath.Join(root, "b")},
+		{Path: "example.com/b/nested", Dir: filepath.Join(root, "b", "nested")},
+	}
+
+	testCases := []struct {
+		desc     string
+		args     []string
+		expected map[string][]string
+	}{
+		{
+			desc: "all the modules",
+			args: []string{filepath.Join(root, "...")},
+			expected: map[string][]string{
+				"example.com/a":        {"./..."},
+				"example.com/b":        {"./..."},
+				"example.com/b/nested": {"./..."},
+			},
+		},
+		{
+			desc: "module with a nested module",
+			args: []string{filepath.Join(root, "b", "...")},
+			expected: map[string][]string{
+				"example.com/b":        {"./..."},
+				"example.com/b/nested": {"./..."},
+			},
+		},
+		{
+			desc: "directory of a module",
+			args: []string{filepath.Join(root, "b", "pkg", "...")},
+			expected: map[string][]string{
+				"example.com/b": {"./pkg/..."},
+			},
+		},
+		{
+			desc: "package",
+			args: []string{filepath.Join(root, "b", "nested", "pkg")},
+			expected: map[string][]string{
+				"example.com/b/nested": {"./pkg"},
+			},
+		},
+		{
+			desc: "module root",
+			args: []string{filepath.Join(root, "a"), filepath.Join(root, "a")},
+			expected: map[string][]string{
+				"example.com/a": {"."},
+			},
+		},
+		{
+			desc:     "outside of the modules",
+			args:     []string{mustAbs(t, "/tmp/other/...")},
+			expected: map[string][]string{},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			matched, err := matchModules(modules, test.args)
+			require.NoError(t, err)
+
+			patterns := map[string][]string{}
+			for _, mod := range matched {
+				patterns[mod.Path] = mod.Args
+			}
+
+			assert.Equal(t, test.expected, patterns)
+		})
+	}
+}
+
+func TestFindModules_workspace(t *testing.T) {
+	root := t.TempDir()
+
+	writeModule(t, filepath.Join(root, "a"), "example.com/a")
+	writeModule(t, filepath.Join(root, "b"), "example.com/b")
+
+	goWork := filepath.Join(root, "go.work")
+	require.NoError(t, os.WriteFile(goWork, []byte("go 1.22\n\nuse (\n\t./a\n\t./b\n)\n"), 0o600))
+
+	t.Setenv(string(goutil.EnvGoWork), goWork)
+
+	modules, err := FindModules(config.NewDefault(), goutil.NewEnv(logutils.NewStderrLog(logutils.DebugKeyEmpty)),
+		[]string{filepath.Join(root, "...")})
+	require.NoError(t, err)
+
+	expected := []Module{
+		{Path: "example.com/a", Dir: filepath.Join(root, "a"), Args: []string{"./..."}},
+		{Path: "example.com/b", Dir: filepath.Join(root, "b"), Args: []string{"./..."}},
+	}
+
+	assert.Equal(t, expected, modules)
+}
+
+func TestFindModules_config(t *testing.T) {
+	root := t.TempDir()
+
+	writeModule(t, filepath.Join(root, "a"), "example.com/a")
+	writeModule(t, filepath.Join(root, "a", "nested"), "example.com/a/nested")
+
+	cfg := config.NewDefault()
+	cfg.Run.Modules = []string{filepath.Join(root, "a"), filepath.Join(root, "a", "nested")}
+
+	t.Setenv(string(goutil.EnvGoWork), "off")
+
+	modules, err := FindModules(cfg, goutil.NewEnv(logutils.NewStderrLog(logutils.DebugKeyEmpty)),
+		[]string{filepath.Join(root, "a", "...")})
+	require.NoError(t, err)
+
+	expected := []Module{
+		{Path: "example.com/a", Dir: filepath.Join(root, "a"), Args: []string{"./..."}},
+		{Path: "example.com/a/nested", Dir: filepath.Join(root, "a", "nested"), Args: []string{"./..."}},
+	}
+
+	assert.Equal(t, expected, modules)
+}
+
+func TestFindModules_single(t *testing.T) {
+	t.Setenv(string(goutil.EnvGoWork), "off")
+
+	modules, err := FindModules(config.NewDefault(), goutil.NewEnv(logutils.NewStderrLog(logutils.DebugKeyEmpty)), nil)
+	require.NoError(t, err)
+
+	assert.Nil(t, modules)
+}
+
+func writeModule(t *testing.T, dir, path string) {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(dir, 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module "+path+"\n\ngo 1.22\n"), 0o600))
+}
@@ -0,0 +1,114 @@
+package lint
+
+import (
+	"path/filepath"
+	"sort"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/lint/lintersdb"
+)
+
+// scopedLinter is a linter running on the packages of a scope.
+type scopedLinter struct {
+	lc      *linter.Config
+	lintCtx *linter.Context
+}
+
+// scopeLinters returns the linters to run: the linters of the run on the packages outside the overrides of the configuration,
+// and the linters of each override on the packages of the override.
+// The linters changing the types run after all the other linters: the packages of the scopes share their dependencies.
+func (r *Runner) scopeLinters(linters []*linter.Config) []scopedLinter {
+	var scopedLinters []scopedLinter
+
+	if len(r.scopes) == 0 {
+		for _, lc := range linters {
+			scopedLinters = append(scopedLinters, scopedLinter{lc: lc, lintCtx: r.lintCtx})
+		}
+
+		return scopedLinters
+	}
+
+	contexts := splitContext(r.lintCtx, r.scopes)
+
+	for i, lintCtx := range contexts {
+		if len(lintCtx.Packages) == 0 {
+			continue
+		}
+
+		scopeLinters := linters
+		if i > 0 {
+			scopeLinters = r.scopes[i-1].Linters
+
+			r.Log.Infof("Analyzing %d packages of %v with the linters of the override #%d",
+				len(lintCtx.Packages), r.scopes[i-1].Dirs, i-1)
+		}
+
+		for _, lc := range scopeLinters {
+			scopedLinters = append(scopedLinters, scopedLinter{lc: lc, lintCtx: lintCtx})
+		}
+	}
+
+	sort.SliceStable(scopedLinters, func(i, j int) bool {
+		return !scopedLinters[i].lc.DoesChangeTypes && scopedLinters[j].lc.DoesChangeTypes
+	})
+
+	return scopedLinters
+}
+
+// splitContext returns the contexts of the scopes: the first context contains the packages outside the scopes,
+// the next contexts contain the packages of each scope, with the configuration of the scope.
+func splitContext(lintCtx *linter.Context, scopes []lintersdb.Scope) []*linter.Context {
+	contexts := make([]*linter.Context, len(scopes)+1)
+
+	for i := range contexts {
+		scopeCtx := *lintCtx
+		scopeCtx.Packages = nil
+		scopeCtx.OriginalPackages = nil
+
+		if i > 0 {
+			scopeCtx.Cfg = scopes[i-1].Cfg
+		}
+
+		contexts[i] = &scopeCtx
+	}
+
+	for _, pkg := range lintCtx.Packages {
+		scopeCtx := contexts[findPackageScope(scopes, pkg)+1]
+		scopeCtx.Packages = append(scopeCtx.Packages, pkg)
+	}
+
+	for _, pkg := range lintCtx.OriginalPackages {
+		scopeCtx := contexts[findPackageScope(scopes, pkg)+1]
+		scopeCtx.OriginalPackages = append(scopeCtx.OriginalPackages, pkg)
+	}
+
+	return contexts
+}
+
+// findPackageScope returns the index of the last scope containing the directory of the package, or -1.
+func findPackageScope(scopes []lintersdb.Scope, pkg *packages.Package) int {
+	var dir string
+
+	for _, files := range [][]string{pkg.GoFiles, pkg.CompiledGoFiles, pkg.OtherFiles} {
+		if len(files) > 0 {
+			dir = filepath.Dir(files[0])
+			break
+		}
+	}
+
+	if dir == "" {
+		return -1
+	}
+
+	for i := len(scopes) - 1; i >= 0; i-- {
+		for _, scopeDir := range scopes[i].Dirs {
+			if isWithin(dir, scopeDir) {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,63 @@
+package lint
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/lint/lintersdb"
+)
+
+func Test_splitContext(t *testing.T) {
+	root := mustAbs(t, "/tmp/overrides")
+
+	newPackage := func(id, dir string) *packages.Package {
+		return &packages.Package{ID: id, GoFiles: []string{filepath.Join(root, dir, "file.go")}}
+	}
+
+	pkgs := []*packages.Package{
+		newPackage("main", "cmd"),
+		newPackage("legacy", filepath.Join("internal", "legacy")),
+		newPackage("legacy/old", filepath.Join("internal", "legacy", "old")),
+		newPackage("internal", "internal"),
+		{ID: "empty"},
+	}
+
+	legacyCfg := config.NewDefault()
+	oldCfg := config.NewDefault()
+
+	scopes := []lintersdb.Scope{
+		{Dirs: []string{filepath.Join(root, "internal", "legacy")}, Cfg: legacyCfg},
+		{Dirs: []string{filepath.Join(root, "cmd"), filepath.Join(root, "internal", "legacy", "old")}, Cfg: oldCfg},
+	}
+
+	cfg := config.NewDefault()
+
+	contexts := splitContext(&linter.Context{Packages: pkgs, OriginalPackages: pkgs, Cfg: cfg}, scopes)
+	require.Len(t, contexts, 3)
+
+	expected := [][]string{
+		{"internal", "empty"},
+		{"legacy"},
+		{"main", "legacy/old"},
+	}
+
+	for i, lintCtx := range contexts {
+		var ids []string
+		for _, pkg := range lintCtx.Packages {
+			ids = append(ids, pkg.ID)
+		}
+
+		assert.Equal(t, expected[i], ids)
+		assert.Equal(t, lintCtx.Packages, lintCtx.OriginalPackages)
+	}
+
+	assert.Same(t, cfg, contexts[0].Cfg)
+	assert.Same(t, legacyCfg, contexts[1].Cfg)
+	assert.Same(t, oldCfg, contexts[2].Cfg)
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -35,6 +36,15 @@ type PackageLoader struct {
 	goenv *goutil.Env
 
 	loadGuard *load.Guard
+
+	// overlay contains the content of the files that differ from the disk (absolute path -> content).
+	overlay map[string][]byte
+
+	// pkgsCache keeps the packages between the loadings (optional).
+	pkgsCache *PackagesCache
+
+	// dir is the directory of the loading (the working directory if empty).
+	dir string
 }
 
 // NewPackageLoader creates a new PackageLoader.
@@ -50,11 +60,39 @@ func NewPackageLoader(log logutils.Log, cfg *config.Config, args []string, goenv
 	}
 }
 
+// SetOverlay defines the content of the files that differ from the disk (e.g. the unsaved files of an editor).
+// The keys are absolute paths.
+func (l *PackageLoader) SetOverlay(overlay map[string][]byte) {
+	l.overlay = overlay
+}
+
+// SetDir loads the packages from a directory (e.g. the directory of a module of a workspace):
+// the arguments are relative to this directory.
+func (l *PackageLoader) SetDir(dir string) {
+	l.dir = dir
+}
+
+// SetPackagesCache reuses the packages loaded by the previous runs of a long-running process.
+func (l *PackageLoader) SetPackagesCache(pkgsCache *PackagesCache) {
+	l.pkgsCache = pkgsCache
+}
+
 // Load loads packages.
 func (l *PackageLoader) Load(ctx context.Context, linters []*linter.Config) (pkgs, deduplicatedPkgs []*packages.Package, err error) {
 	loadMode := findLoadMode(linters)
 
-	pkgs, err = l.loadPackages(ctx, loadMode)
+	l.prepareBuildContext()
+
+	args := buildArgs(l.args)
+
+	if l.cfg.Run.ChangedSince != "" {
+		args, err = l.changedPackagesArgs(ctx, args, needDependents(linters))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	pkgs, err = l.loadPackages(ctx, loadMode, args)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to load packages: %w", err)
 	}
@@ -62,27 +100,25 @@ func (l *PackageLoader) Load(ctx context.Context, linters []*linter.Config) (pkg
 	return pkgs, l.filterDuplicatePackages(pkgs), nil
 }
 
-func (l *PackageLoader) loadPackages(ctx context.Context, loadMode packages.LoadMode) ([]*packages.Package, error) {
+func (l *PackageLoader) loadPackages(ctx context.Context, loadMode packages.LoadMode, args []string) ([]*packages.Package, error) {
 	defer func(startedAt time.Time) {
 		l.log.Infof("Go packages loading at mode %s took %s", stringifyLoadMode(loadMode), time.Since(startedAt))
 	}(time.Now())
 
-	l.prepareBuildContext()
-
 	conf := &packages.Config{
 		Mode:       loadMode,
+		Dir:        l.dir,
 		Tests:      l.cfg.Run.AnalyzeTests,
 		Context:    ctx,
 		BuildFlags: l.makeBuildFlags(),
 		Logf:       l.debugf,
-		// TODO: use fset, parsefile, overlay
+		Overlay:    l.overlay,
+		// TODO: use fset, parsefile
 	}
 
-	args := buildArgs(l.args)
-
 	l.debugf("Built loader args are %s", args)
 
-	pkgs, err := packages.Load(conf, args...)
+	pkgs, err := l.loadOrReuse(conf, args)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load with go/packages: %w", err)
 	}
@@ -105,6 +141,84 @@ func (l *PackageLoader) loadPackages(ctx context.Context, loadMode packages.Load
 	return l.filterTestMainPackages(pkgs), nil
 }
 
+// loadOrReuse loads the packages, or reuses the packages of the previous loading if they are still valid.
+func (l *PackageLoader) loadOrReuse(conf *packages.Config, args []string) ([]*packages.Package, error) {
+	if l.pkgsCache == nil {
+		return packages.Load(conf, args...)
+	}
+
+	key := fmt.Sprintf("%d %t %q %q %q", conf.Mode, conf.Tests, conf.BuildFlags, conf.Dir, args)
+
+	pkgs, stale := l.pkgsCache.get(key)
+	if pkgs != nil {
+		err := l.refreshExportFiles(conf, stale)
+		if err == nil {
+			l.log.Infof("Reusing the loaded packages (%d stale)", len(stale))
+			return pkgs, nil
+		}
+
+		l.log.Infof("Can't reuse the loaded packages: %v", err)
+	}
+
+	pkgs, err := packages.Load(conf, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	l.pkgsCache.set(key, pkgs)
+
+	return pkgs, nil
+}
+
+// refreshExportFiles updates the export data, and the compilation errors, of the packages modified since the previous loading:
+// the export data are used to load the dependencies of the analyzed packages.
+func (l *PackageLoader) refreshExportFiles(conf *packages.Config, stale []*packages.Package) error {
+	if conf.Mode&packages.NeedExportFile == 0 {
+		return nil
+	}
+
+	// The implicit testmain packages are not analyzed.
+	stale = slices.DeleteFunc(stale, isTestMainPackage)
+
+	var patterns []string
+	for _, pkg := range stale {
+		if !slices.Contains(patterns, pkg.PkgPath) {
+			patterns = append(patterns, pkg.PkgPath)
+		}
+	}
+
+	if len(patterns) == 0 {
+		return nil
+	}
+
+	exportConf := *conf
+	exportConf.Mode = packages.NeedName | packages.NeedExportFile
+
+	loaded, err := packages.Load(&exportConf, patterns...)
+	if err != nil {
+		return err
+	}
+
+	loadedByID := map[string]*packages.Package{}
+	for _, pkg := range loaded {
+		loadedByID[pkg.ID] = pkg
+	}
+
+	updates := map[*packages.Package]*packages.Package{}
+	for _, pkg := range stale {
+		update, ok := loadedByID[pkg.ID]
+		if !ok {
+			return fmt.Errorf("no export data for %s", pkg.ID)
+		}
+
+		updates[pkg] = update
+	}
+
+	l.pkgsCache.setExportFiles(updates)
+
+	return nil
+}
+
 func (*PackageLoader) parseLoadedPackagesErrors(pkgs []*packages.Package) error {
 	for _, pkg := range pkgs {
 		var errs []packages.Error
@@ -177,7 +291,7 @@ func (l *PackageLoader) filterDuplicatePackages(pkgs []*packages.Package) []*pac
 func (l *PackageLoader) filterTestMainPackages(pkgs []*packages.Package) []*packages.Package {
 	var retPkgs []*packages.Package
 	for _, pkg := range pkgs {
-		if pkg.Name == "main" && strings.HasSuffix(pkg.PkgPath, ".test") {
+		if isTestMainPackage(pkg) {
 			// it's an implicit testmain package
 			l.debugf("skip pkg ID=%s", pkg.ID)
 			continue
@@ -281,3 +395,7 @@ func stringifyLoadMode(mode packages.LoadMode) string {
 
 	return fmt.Sprintf("%d (%s)", mode, strings.Join(flags, "|"))
 }
+
+func isTestMainPackage(pkg *packages.Package) bool {
+	return pkg.Name == "main" && strings.HasSuffix(pkg.PkgPath, ".test")
+}
@@ -0,0 +1,318 @@
+package lint
+
+import (
+	"crypto/sha256"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+)
+
+// PackagesCache keeps the packages loaded by a [PackageLoader] between the runs of a long-running process
+// (e.g. the daemon, the language server).
+//
+// The packages are reused as long as the packages graph doesn't change:
+// a new, removed or renamed file, a change of the imports or the build constraints,
+// or a change of the go.mod file requires loading the packages again.
+// The other changes of the files only invalidate the packages containing them, and their reverse dependencies.
+type PackagesCache struct {
+	mu sync.Mutex
+
+	// key identifies the arguments and the options of the loading.
+	key string
+
+	// roots are the packages returned by packages.Load.
+	roots []*packages.Package
+
+	// snapshots contain the fields of the packages as returned by packages.Load:
+	// the packages are modified during the analysis.
+	snapshots map[*packages.Package]*packages.Package
+
+	files     map[string][]*packages.Package
+	headers   map[string][sha256.Size]byte
+	importers map[*packages.Package][]*packages.Package
+
+	// stale packages have outdated export data.
+	stale map[*packages.Package]bool
+
+	// overlay contains the content of the files that differ from the disk (see SetOverlay).
+	overlay map[string][]byte
+}
+
+// NewPackagesCache creates a new PackagesCache.
+func NewPackagesCache() *PackagesCache {
+	return &PackagesCache{}
+}
+
+// SetOverlay defines the content of the files that differ from the disk (e.g. the unsaved files of an editor).
+// It must be called before the invalidation of the files whose content has changed inside the overlay.
+func (c *PackagesCache) SetOverlay(overlay map[string][]byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.overlay = overlay
+}
+
+// Invalidate invalidates the packages containing the changed files (absolute paths).
+// It returns the invalidated packages and their reverse dependencies,
+// or all the packages if they must be loaded again.
+func (c *PackagesCache) Invalidate(files []string) []*packages.Package {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.roots == nil {
+		return nil
+	}
+
+	var changed []*packages.Package
+
+	for _, file := range files {
+		if isIgnoredByGo(file) {
+			continue
+		}
+
+		switch filepath.Base(file) {
+		case "go.mod", "go.sum", "go.work", "go.work.sum", "modules.txt":
+			return c.reset()
+		}
+
+		pkgs, ok := c.files[file]
+		if !ok {
+			if strings.HasSuffix(file, ".go") {
+				// New Go file.
+				return c.reset()
+			}
+
+			continue
+		}
+
+		// The headers are only known for the files of the roots:
+		// a change of the files of the other packages resets the cache.
+		header, err := c.fileHeader(file)
+		if err != nil || header != c.headers[file] {
+			// The file has been removed, or the imports or the build constraints have changed.
+			return c.reset()
+		}
+
+		for _, pkg := range pkgs {
+			switch {
+			case slices.Contains(pkg.CompiledGoFiles, file):
+				changed = append(changed, pkg)
+
+			case slices.Contains(pkg.GoFiles, file):
+				// The compiled files of cgo are generated by the go command.
+				return c.reset()
+			}
+		}
+	}
+
+	pkgs := c.withImporters(changed)
+	for _, pkg := range pkgs {
+		c.stale[pkg] = true
+	}
+
+	return pkgs
+}
+
+// withImporters returns the packages and their transitive importers.
+func (c *PackagesCache) withImporters(pkgs []*packages.Package) []*packages.Package {
+	visited := map[*packages.Package]bool{}
+
+	var result []*packages.Package
+
+	var visit func(pkg *packages.Package)
+	visit = func(pkg *packages.Package) {
+		if visited[pkg] {
+			return
+		}
+
+		visited[pkg] = true
+		result = append(result, pkg)
+
+		for _, importer := range c.importers[pkg] {
+			visit(importer)
+		}
+	}
+
+	for _, pkg := range pkgs {
+		visit(pkg)
+	}
+
+	return result
+}
+
+// Reset drops all the packages and returns them.
+func (c *PackagesCache) Reset() []*packages.Package {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.reset()
+}
+
+// reset drops the packages and returns them.
+func (c *PackagesCache) reset() []*packages.Package {
+	pkgs := make([]*packages.Package, 0, len(c.snapshots))
+	for pkg := range c.snapshots {
+		pkgs = append(pkgs, pkg)
+	}
+
+	c.key = ""
+	c.roots = nil
+	c.snapshots = nil
+	c.files = nil
+	c.headers = nil
+	c.importers = nil
+	c.stale = nil
+
+	return pkgs
+}
+
+// get returns the packages loaded with the key, restored to their state after loading, and the stale packages.
+func (c *PackagesCache) get(key string) (roots, stale []*packages.Package) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.roots == nil || c.key != key {
+		return nil, nil
+	}
+
+	for pkg, snapshot := range c.snapshots {
+		*pkg = *snapshot
+	}
+
+	for pkg := range c.stale {
+		stale = append(stale, pkg)
+	}
+
+	return c.roots, stale
+}
+
+// set stores the packages returned by packages.Load.
+func (c *PackagesCache) set(key string, roots []*packages.Package) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.key = key
+	c.roots = roots
+	c.snapshots = map[*packages.Package]*packages.Package{}
+	c.files = map[string][]*packages.Package{}
+	c.headers = map[string][sha256.Size]byte{}
+	c.importers = map[*packages.Package][]*packages.Package{}
+	c.stale = map[*packages.Package]bool{}
+
+	packages.Visit(roots, nil, func(pkg *packages.Package) {
+		snapshot := *pkg
+		c.snapshots[pkg] = &snapshot
+
+		for _, imp := range pkg.Imports {
+			c.importers[imp] = append(c.importers[imp], pkg)
+		}
+	})
+
+	for _, pkg := range roots {
+		for _, file := range slices.Concat(pkg.GoFiles, pkg.IgnoredFiles) {
+			c.files[file] = append(c.files[file], pkg)
+
+			if _, ok := c.headers[file]; ok {
+				continue
+			}
+
+			header, err := c.fileHeader(file)
+			if err != nil {
+				continue
+			}
+
+			c.headers[file] = header
+		}
+	}
+
+	// The files of the other packages (ex: the packages outside the arguments) can't be reused:
+	// their export data can be outdated.
+	for pkg := range c.snapshots {
+		if slices.Contains(roots, pkg) {
+			continue
+		}
+
+		for _, file := range pkg.GoFiles {
+			if _, ok := c.files[file]; !ok {
+				c.files[file] = nil
+			}
+		}
+	}
+}
+
+// setExportFiles updates the export data and the errors of the stale packages
+// with the packages loaded again with [packages.NeedExportFile].
+func (c *PackagesCache) setExportFiles(updates map[*packages.Package]*packages.Package) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for pkg, update := range updates {
+		snapshot := c.snapshots[pkg]
+		snapshot.ExportFile = update.ExportFile
+		snapshot.Errors = update.Errors
+
+		pkg.ExportFile = update.ExportFile
+		pkg.Errors = update.Errors
+
+		delete(c.stale, pkg)
+	}
+}
+
+// fileHeader returns the hash of the beginning of a Go file: the build constraints, the package clause, and the imports.
+// The content of the overlay is used first.
+func (c *PackagesCache) fileHeader(filename string) ([sha256.Size]byte, error) {
+	src, ok := c.overlay[filename]
+	if !ok {
+		var err error
+
+		src, err = os.ReadFile(filename)
+		if err != nil {
+			return [sha256.Size]byte{}, err
+		}
+	}
+
+	fset := token.NewFileSet()
+
+	file, err := parser.ParseFile(fset, filename, src, parser.ImportsOnly|parser.ParseComments)
+	if err != nil {
+		// The syntax errors are reported by the analysis.
+		return sha256.Sum256(src), nil
+	}
+
+	end := file.Name.End()
+	if len(file.Imports) > 0 {
+		end = file.Imports[len(file.Imports)-1].End()
+	}
+
+	return sha256.Sum256(src[:fset.Position(end).Offset]), nil
+}
+
+// isIgnoredByGo reports whether the go command ignores the file (ex: testdata, hidden directories).
+// Only the path relative to the working directory is checked.
+func isIgnoredByGo(file string) bool {
+	wd, err := fsutils.Getwd()
+	if err != nil {
+		return false
+	}
+
+	rel, err := filepath.Rel(wd, file)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return false
+	}
+
+	for _, elem := range strings.Split(filepath.ToSlash(rel), "/") {
+		if elem == "testdata" || strings.HasPrefix(elem, ".") || strings.HasPrefix(elem, "_") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,188 @@
+package lint
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	testFileA = "package a\n\nimport \"fmt\"\n\nfunc A() { fmt.Println(\"a\") }\n"
+	testFileB = "package b\n\nimport \"example.com/a\"\n\nfunc B() { a.A() }\n"
+)
+
+func TestPackagesCache_Invalidate(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		file     string
+		content  string
+		expected []string
+		reset    bool
+
+		// unsaved content: the file isn't written.
+		overlay bool
+	}{
+		{
+			desc:     "body of a dependency",
+			file:     "a/a.go",
+			content:  "package a\n\nimport \"fmt\"\n\nfunc A() { fmt.Println(\"A\") }\n",
+			expected: []string{"example.com/a", "example.com/b"},
+		},
+		{
+			desc:     "body of an importer",
+			file:     "b/b.go",
+			content:  "package b\n\nimport \"example.com/a\"\n\nfunc B() {\n\ta.A()\n}\n",
+			expected: []string{"example.com/b"},
+		},
+		{
+			desc:    "imports",
+			file:    "a/a.go",
+			content: "package a\n\nimport \"os\"\n\nfunc A() { os.Exit(1) }\n",
+			reset:   true,
+		},
+		{
+			desc:    "build constraints",
+			file:    "a/a.go",
+			content: "//go:build linux\n\n" + testFileA,
+			reset:   true,
+		},
+		{
+			desc:    "new file",
+			file:    "a/new.go",
+			content: "package a\n",
+			reset:   true,
+		},
+		{
+			desc:    "go.mod",
+			file:    "go.mod",
+			content: "module example.com\n\ngo 1.22\n",
+			reset:   true,
+		},
+		{
+			desc:    "other file",
+			file:    "a/README.md",
+			content: "# a\n",
+		},
+		{
+			desc:     "unsaved body of a dependency",
+			file:     "a/a.go",
+			content:  "package a\n\nimport \"fmt\"\n\nfunc A() { fmt.Println(\"A\") }\n",
+			expected: []string{"example.com/a", "example.com/b"},
+			overlay:  true,
+		},
+		{
+			desc:    "unsaved imports",
+			file:    "a/a.go",
+			content: "package a\n\nimport \"os\"\n\nfunc A() { os.Exit(1) }\n",
+			reset:   true,
+			overlay: true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			dir, pkgs := setupPackagesCache(t)
+
+			cache := NewPackagesCache()
+			cache.set("key", pkgs)
+
+			file := filepath.Join(dir, filepath.FromSlash(test.file))
+
+			if test.overlay {
+				cache.SetOverlay(map[string][]byte{file: []byte(test.content)})
+			} else {
+				require.NoError(t, os.WriteFile(file, []byte(test.content), 0o600))
+			}
+
+			invalidated := cache.Invalidate([]string{file})
+
+			roots, stale := cache.get("key")
+
+			if test.reset {
+				assert.Len(t, invalidated, len(pkgs))
+				assert.Nil(t, roots)
+				return
+			}
+
+			assert.ElementsMatch(t, test.expected, pkgPaths(invalidated))
+			assert.Equal(t, pkgs, roots)
+			assert.ElementsMatch(t, test.expected, pkgPaths(stale))
+		})
+	}
+}
+
+func TestPackagesCache_get(t *testing.T) {
+	t.Parallel()
+
+	_, pkgs := setupPackagesCache(t)
+
+	cache := NewPackagesCache()
+	cache.set("key", pkgs)
+
+	// The packages are modified by the analysis.
+	pkgs[0].IllTyped = true
+	pkgs[0].Syntax = nil
+
+	roots, stale := cache.get("other")
+	assert.Nil(t, roots)
+	assert.Nil(t, stale)
+
+	roots, stale = cache.get("key")
+	assert.Equal(t, pkgs, roots)
+	assert.Empty(t, stale)
+
+	assert.False(t, pkgs[0].IllTyped)
+
+	cache.setExportFiles(map[*packages.Package]*packages.Package{
+		pkgs[0]: {ExportFile: "a.x"},
+	})
+
+	roots, _ = cache.get("key")
+	assert.Equal(t, "a.x", roots[0].ExportFile)
+}
+
+func setupPackagesCache(t *testing.T) (string, []*packages.Package) {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	fileA := filepath.Join(dir, "a", "a.go")
+	fileB := filepath.Join(dir, "b", "b.go")
+
+	for file, content := range map[string]string{fileA: testFileA, fileB: testFileB} {
+		require.NoError(t, os.MkdirAll(filepath.Dir(file), 0o700))
+		require.NoError(t, os.WriteFile(file, []byte(content), 0o600))
+	}
+
+	pkgA := &packages.Package{
+		ID:              "example.com/a",
+		PkgPath:         "example.com/a",
+		GoFiles:         []string{fileA},
+		CompiledGoFiles: []string{fileA},
+	}
+
+	pkgB := &packages.Package{
+		ID:              "example.com/b",
+		PkgPath:         "example.com/b",
+		GoFiles:         []string{fileB},
+		CompiledGoFiles: []string{fileB},
+		Imports:         map[string]*packages.Package{"example.com/a": pkgA},
+	}
+
+	return dir, []*packages.Package{pkgA, pkgB}
+}
+
+func pkgPaths(pkgs []*packages.Package) []string {
+	var paths []string
+	for _, pkg := range pkgs {
+		paths = append(paths, pkg.PkgPath)
+	}
+
+	return paths
+}
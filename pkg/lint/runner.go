@@ -4,9 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"runtime/debug"
 	"strings"
 
+	"golang.org/x/tools/go/packages"
+
 	"github.com/golangci/golangci-lint/internal/errorutil"
 	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/fsutils"
@@ -29,12 +32,90 @@ type Runner struct {
 
 	lintCtx    *linter.Context
 	Processors []processors.Processor
+
+	// scopes are the linters of the overrides of the configuration (see lintersdb.Manager.GetOptimizedScopes).
+	scopes []lintersdb.Scope
+
+	// streamer sends the issues of the linters to a stream as soon as the linters finish (see SetStream).
+	streamer *issuesStreamer
 }
 
 func NewRunner(log logutils.Log, cfg *config.Config, args []string, goenv *goutil.Env,
 	lineCache *fsutils.LineCache, fileCache *fsutils.FileCache,
 	dbManager *lintersdb.Manager, lintCtx *linter.Context,
 ) (*Runner, error) {
+	packagesProcessors, err := newPackagesProcessors(log, cfg, args, goenv, lineCache, fileCache, dbManager, lintCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	reportProcessors, err := newReportProcessors(log, cfg, lineCache, fileCache, dbManager, PackagesDirs(lintCtx.Packages))
+	if err != nil {
+		return nil, err
+	}
+
+	scopes, err := dbManager.GetOptimizedScopes()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Runner{
+		Processors: append(packagesProcessors, reportProcessors...),
+		lintCtx:    lintCtx,
+		scopes:     scopes,
+		Log:        log,
+	}, nil
+}
+
+// NewModuleRunner creates a runner of the linters on the packages of a module of a run with several modules.
+// Only the processors depending on the configuration of the module (exclusions, nolint, etc.) are applied:
+// the issues of the modules are merged, and processed by the runner created by NewReportRunner.
+func NewModuleRunner(log logutils.Log, cfg *config.Config, args []string, goenv *goutil.Env,
+	lineCache *fsutils.LineCache, fileCache *fsutils.FileCache,
+	dbManager *lintersdb.Manager, lintCtx *linter.Context,
+) (*Runner, error) {
+	packagesProcessors, err := newPackagesProcessors(log, cfg, args, goenv, lineCache, fileCache, dbManager, lintCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes, err := dbManager.GetOptimizedScopes()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Runner{
+		Processors: packagesProcessors,
+		lintCtx:    lintCtx,
+		scopes:     scopes,
+		Log:        log,
+	}, nil
+}
+
+// NewReportRunner creates a runner processing the issues of the modules of a run with several modules (see NewModuleRunner):
+// the baseline, the limits, the severities, the fixes, and the order of the issues depend on the configuration of the run.
+// It doesn't run linters (see Runner.Process).
+// The analyzedDirs are the directories of the packages of all the modules (see PackagesDirs).
+func NewReportRunner(log logutils.Log, cfg *config.Config,
+	lineCache *fsutils.LineCache, fileCache *fsutils.FileCache, dbManager *lintersdb.Manager,
+	analyzedDirs []string,
+) (*Runner, error) {
+	reportProcessors, err := newReportProcessors(log, cfg, lineCache, fileCache, dbManager, analyzedDirs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Runner{
+		Processors: reportProcessors,
+		Log:        log,
+	}, nil
+}
+
+// newPackagesProcessors creates the processors depending on the packages, and on the configuration of their module.
+func newPackagesProcessors(log logutils.Log, cfg *config.Config, args []string, goenv *goutil.Env,
+	lineCache *fsutils.LineCache, fileCache *fsutils.FileCache,
+	dbManager *lintersdb.Manager, lintCtx *linter.Context,
+) ([]processors.Processor, error) {
 	// Beware that some processors need to add the path prefix when working with paths
 	// because they get invoked before the path prefixer (exclude and severity rules)
 	// or process other paths (skip files).
@@ -60,51 +141,96 @@ func NewRunner(log logutils.Log, cfg *config.Config, args []string, goenv *gouti
 		return nil, fmt.Errorf("failed to get enabled linters: %w", err)
 	}
 
-	return &Runner{
-		Processors: []processors.Processor{
-			processors.NewCgo(goenv),
-
-			// Must go after Cgo.
-			processors.NewFilenameUnadjuster(lintCtx.Packages, log.Child(logutils.DebugKeyFilenameUnadjuster)),
-
-			// Must go after FilenameUnadjuster.
-			processors.NewInvalidIssue(log.Child(logutils.DebugKeyInvalidIssue)),
-
-			// Must be before diff, nolint and exclude autogenerated processor at least.
-			processors.NewPathPrettifier(),
-			skipFilesProcessor,
-			skipDirsProcessor, // must be after path prettifier
-
-			processors.NewAutogeneratedExclude(cfg.Issues.ExcludeGenerated),
-
-			// Must be before exclude because users see already marked output and configure excluding by it.
-			processors.NewIdentifierMarker(),
-
-			processors.NewExclude(&cfg.Issues),
-			processors.NewExcludeRules(log.Child(logutils.DebugKeyExcludeRules), files, &cfg.Issues),
-			processors.NewNolint(log.Child(logutils.DebugKeyNolint), dbManager, enabledLinters),
-
-			processors.NewUniqByLine(cfg),
-			processors.NewDiff(&cfg.Issues),
-			processors.NewMaxPerFileFromLinter(cfg),
-			processors.NewMaxSameIssues(cfg.Issues.MaxSameIssues, log.Child(logutils.DebugKeyMaxSameIssues), cfg),
-			processors.NewMaxFromLinter(cfg.Issues.MaxIssuesPerLinter, log.Child(logutils.DebugKeyMaxFromLinter), cfg),
-			processors.NewSourceCode(lineCache, log.Child(logutils.DebugKeySourceCode)),
-			processors.NewPathShortener(),
-			processors.NewSeverity(log.Child(logutils.DebugKeySeverityRules), files, &cfg.Severity),
-
-			// The fixer still needs to see paths for the issues that are relative to the current directory.
-			processors.NewFixer(cfg, log, fileCache),
-
-			// Now we can modify the issues for output.
-			processors.NewPathPrefixer(cfg.Output.PathPrefix),
-			processors.NewSortResults(cfg),
-		},
-		lintCtx: lintCtx,
-		Log:     log,
+	return []processors.Processor{
+		processors.NewCgo(goenv),
+
+		// Must go after Cgo.
+		processors.NewFilenameUnadjuster(lintCtx.Packages, log.Child(logutils.DebugKeyFilenameUnadjuster)),
+
+		// Must go after FilenameUnadjuster.
+		processors.NewInvalidIssue(log.Child(logutils.DebugKeyInvalidIssue), commandLinters(cfg)),
+
+		// Must be before diff, nolint and exclude autogenerated processor at least.
+		processors.NewPathPrettifier(),
+		skipFilesProcessor,
+		skipDirsProcessor, // must be after path prettifier
+
+		processors.NewAutogeneratedExclude(cfg.Issues.ExcludeGenerated),
+
+		// Must be before exclude because users see already marked output and configure excluding by it.
+		processors.NewIdentifierMarker(),
+
+		processors.NewExclude(&cfg.Issues),
+		processors.NewExcludeRules(log.Child(logutils.DebugKeyExcludeRules), files, &cfg.Issues),
+		processors.NewNolint(log.Child(logutils.DebugKeyNolint), dbManager, enabledLinters, fileCache),
+
+		// Must be after the exclusions (nolint included) and before the processors that limit the issues:
+		// the occurrence indexes depend on the issues seen.
+		processors.NewFingerprint(log.Child(logutils.DebugKeyFingerprint), lineCache),
 	}, nil
 }
 
+// newReportProcessors creates the processors depending on all the issues of the run.
+func newReportProcessors(log logutils.Log, cfg *config.Config,
+	lineCache *fsutils.LineCache, fileCache *fsutils.FileCache, dbManager *lintersdb.Manager,
+	analyzedDirs []string,
+) ([]processors.Processor, error) {
+	files := fsutils.NewFiles(lineCache, cfg.Output.PathPrefix)
+
+	enabledLinters, err := dbManager.GetEnabledLintersMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get enabled linters: %w", err)
+	}
+
+	baselineProcessor, err := processors.NewBaseline(log.Child(logutils.DebugKeyBaseline), &cfg.Issues, enabledLinters, analyzedDirs)
+	if err != nil {
+		return nil, err
+	}
+
+	return []processors.Processor{
+		// Must be after the fingerprint.
+		baselineProcessor,
+
+		// Must be before the uniqueness by line: the sources of the merged issues are kept.
+		processors.NewEquivalents(log.Child(logutils.DebugKeyEquivalents), &cfg.Issues),
+		processors.NewUniqByLine(cfg),
+		processors.NewDiff(cfg),
+		processors.NewMaxPerFileFromLinter(cfg),
+		processors.NewMaxSameIssues(cfg.Issues.MaxSameIssues, log.Child(logutils.DebugKeyMaxSameIssues), cfg),
+		processors.NewMaxFromLinter(cfg.Issues.MaxIssuesPerLinter, log.Child(logutils.DebugKeyMaxFromLinter), cfg),
+		processors.NewSourceCode(lineCache, log.Child(logutils.DebugKeySourceCode)),
+		processors.NewPathShortener(),
+		processors.NewSeverity(log.Child(logutils.DebugKeySeverityRules), files, &cfg.Severity),
+
+		// The fixer still needs to see paths for the issues that are relative to the current directory.
+		processors.NewFixer(cfg, log, fileCache),
+
+		// Now we can modify the issues for output.
+		processors.NewPathPrefixer(cfg.Output.PathPrefix),
+		processors.NewSortResults(cfg),
+	}, nil
+}
+
+// PackagesDirs returns the directories of the files of the packages.
+func PackagesDirs(pkgs []*packages.Package) []string {
+	dirs := []string{}
+	seen := map[string]bool{}
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.GoFiles {
+			dir := filepath.Dir(file)
+			if seen[dir] {
+				continue
+			}
+
+			seen[dir] = true
+			dirs = append(dirs, dir)
+		}
+	}
+
+	return dirs
+}
+
 func (r *Runner) Run(ctx context.Context, linters []*linter.Config) ([]result.Issue, error) {
 	sw := timeutils.NewStopwatch("linters", r.Log)
 	defer sw.Print()
@@ -114,9 +240,19 @@ func (r *Runner) Run(ctx context.Context, linters []*linter.Config) ([]result.Is
 		issues     []result.Issue
 	)
 
-	for _, lc := range linters {
+	scopedLinters := r.scopeLinters(linters)
+
+	for i, sl := range scopedLinters {
+		lc := sl.lc
+
+		// Don't start new linters after the cancellation: the issues of the completed linters are kept.
+		if ctx.Err() != nil {
+			r.Log.Infof("Skipped %d linters: %v", len(scopedLinters)-i, ctx.Err())
+			break
+		}
+
 		sw.TrackStage(lc.Name(), func() {
-			linterIssues, err := r.runLinterSafe(ctx, r.lintCtx, lc)
+			linterIssues, err := r.runLinterSafe(ctx, sl.lintCtx, lc)
 			if err != nil {
 				lintErrors = errors.Join(lintErrors, fmt.Errorf("can't run linter %s", lc.Linter.Name()), err)
 				r.Log.Warnf("Can't run linter %s: %v", lc.Linter.Name(), err)
@@ -124,11 +260,26 @@ func (r *Runner) Run(ctx context.Context, linters []*linter.Config) ([]result.Is
 				return
 			}
 
+			if r.streamer != nil {
+				linterIssues = r.streamer.process(lc.Name(), linterIssues, i+1, len(scopedLinters))
+			}
+
 			issues = append(issues, linterIssues...)
 		})
 	}
 
-	return r.processLintResults(issues), lintErrors
+	if r.streamer != nil {
+		issues = append(issues, r.streamer.finish()...)
+
+		return r.processLintResults(issues, r.streamer.deferred), lintErrors
+	}
+
+	return r.processLintResults(issues, r.Processors), lintErrors
+}
+
+// Process processes the issues of the modules of a run with several modules (see NewReportRunner).
+func (r *Runner) Process(issues []result.Issue) []result.Issue {
+	return r.processLintResults(issues, r.Processors)
 }
 
 func (r *Runner) runLinterSafe(ctx context.Context, lintCtx *linter.Context,
@@ -172,7 +323,8 @@ func (r *Runner) runLinterSafe(ctx context.Context, lintCtx *linter.Context,
 	return issues, nil
 }
 
-func (r *Runner) processLintResults(inIssues []result.Issue) []result.Issue {
+// processLintResults processes the issues with the processors, and finishes all the processors.
+func (r *Runner) processLintResults(inIssues []result.Issue, procs []processors.Processor) []result.Issue {
 	sw := timeutils.NewStopwatch("processing", r.Log)
 
 	var issuesBefore, issuesAfter int
@@ -181,7 +333,7 @@ func (r *Runner) processLintResults(inIssues []result.Issue) []result.Issue {
 	var outIssues []result.Issue
 	if len(inIssues) != 0 {
 		issuesBefore += len(inIssues)
-		outIssues = r.processIssues(inIssues, sw, statPerProcessor)
+		outIssues = r.processIssues(inIssues, sw, statPerProcessor, procs)
 		issuesAfter += len(outIssues)
 	}
 
@@ -214,8 +366,10 @@ func (r *Runner) printPerProcessorStat(stat map[string]processorStat) {
 	}
 }
 
-func (r *Runner) processIssues(issues []result.Issue, sw *timeutils.Stopwatch, statPerProcessor map[string]processorStat) []result.Issue {
-	for _, p := range r.Processors {
+func (r *Runner) processIssues(issues []result.Issue, sw *timeutils.Stopwatch, statPerProcessor map[string]processorStat,
+	procs []processors.Processor,
+) []result.Issue {
+	for _, p := range procs {
 		var newIssues []result.Issue
 		var err error
 		sw.TrackStage(p.Name(), func() {
@@ -240,3 +394,16 @@ func (r *Runner) processIssues(issues []result.Issue, sw *timeutils.Stopwatch, s
 
 	return issues
 }
+
+// commandLinters returns the names of the `command` custom linters: their issues are not always in Go files.
+func commandLinters(cfg *config.Config) []string {
+	var names []string
+
+	for name := range cfg.LintersSettings.Custom {
+		if cfg.LintersSettings.Custom[name].Type == "command" {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
@@ -0,0 +1,102 @@
+package lint
+
+import (
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/result"
+	"github.com/golangci/golangci-lint/pkg/result/processors"
+	"github.com/golangci/golangci-lint/pkg/timeutils"
+)
+
+// Stream receives the issues of the linters as soon as the linters finish (see Runner.SetStream).
+type Stream interface {
+	// StreamIssues receives the issues processed by the streamable processors.
+	StreamIssues(issues []result.Issue)
+
+	// LinterFinished receives the number of the finished linters, after the issues of the linter.
+	LinterFinished(name string, finished, total int)
+}
+
+// SetStream sends the issues of the linters to the stream as soon as the linters finish.
+// The issues are processed by the leading streamable processors (see processors.StreamProcessor) before being streamed,
+// the processors from the first non-streamable one (limits, uniqueness, order, etc.) process all the issues
+// at the end of the run, in their order: the streamed issues are the issues of the final result before these processors.
+func (r *Runner) SetStream(stream Stream) {
+	s := &issuesStreamer{
+		runner: r,
+		stream: stream,
+		sw:     timeutils.NewStopwatch("stream processing", r.Log),
+		stat:   map[string]processorStat{},
+	}
+
+	s.processors, s.deferred = splitStreamable(r.Processors)
+
+	r.streamer = s
+}
+
+// splitStreamable splits the processors before the first non-streamable processor.
+func splitStreamable(procs []processors.Processor) (streamable, deferred []processors.Processor) {
+	for i, p := range procs {
+		if sp, ok := p.(processors.StreamProcessor); !ok || !sp.IsStreamable() {
+			return procs[:i:i], procs[i:]
+		}
+	}
+
+	return procs, nil
+}
+
+type issuesStreamer struct {
+	runner *Runner
+	stream Stream
+
+	// processors are the leading streamable processors, deferred are the processors of all the issues.
+	processors []processors.Processor
+	deferred   []processors.Processor
+
+	sw   *timeutils.Stopwatch
+	stat map[string]processorStat
+
+	// lastIssues are the issues of nolintlint:
+	// the unused nolint directives are known after the processing of the issues of all the other linters.
+	lastIssues []result.Issue
+}
+
+// process processes the issues of a linter with the streamable processors, and sends them to the stream.
+func (s *issuesStreamer) process(name string, issues []result.Issue, finished, total int) []result.Issue {
+	var linterIssues []result.Issue
+
+	for i := range issues {
+		if issues[i].FromLinter == linter.LastLinter {
+			s.lastIssues = append(s.lastIssues, issues[i])
+		} else {
+			linterIssues = append(linterIssues, issues[i])
+		}
+	}
+
+	if len(linterIssues) > 0 {
+		linterIssues = s.runner.processIssues(linterIssues, s.sw, s.stat, s.processors)
+
+		s.stream.StreamIssues(linterIssues)
+	}
+
+	s.stream.LinterFinished(name, finished, total)
+
+	return linterIssues
+}
+
+// finish processes, and sends to the stream, the issues of nolintlint.
+func (s *issuesStreamer) finish() []result.Issue {
+	defer func() {
+		s.runner.printPerProcessorStat(s.stat)
+		s.sw.PrintStages()
+	}()
+
+	if len(s.lastIssues) == 0 {
+		return nil
+	}
+
+	issues := s.runner.processIssues(s.lastIssues, s.sw, s.stat, s.processors)
+
+	s.stream.StreamIssues(issues)
+
+	return issues
+}
@@ -0,0 +1,87 @@
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+	"github.com/golangci/golangci-lint/pkg/result/processors"
+)
+
+type fakeProcessor struct {
+	name       string
+	streamable bool
+}
+
+func (fakeProcessor) Process(issues []result.Issue) ([]result.Issue, error) {
+	return issues, nil
+}
+
+func (p fakeProcessor) Name() string {
+	return p.name
+}
+
+func (fakeProcessor) Finish() {}
+
+func (p fakeProcessor) IsStreamable() bool {
+	return p.streamable
+}
+
+func Test_splitStreamable(t *testing.T) {
+	testCases := []struct {
+		desc               string
+		procs              []processors.Processor
+		expectedStreamable []string
+		expectedDeferred   []string
+	}{
+		{
+			desc: "all streamable",
+			procs: []processors.Processor{
+				fakeProcessor{name: "a", streamable: true},
+				fakeProcessor{name: "b", streamable: true},
+			},
+			expectedStreamable: []string{"a", "b"},
+		},
+		{
+			desc: "streamable after a non-streamable processor",
+			procs: []processors.Processor{
+				fakeProcessor{name: "a", streamable: true},
+				fakeProcessor{name: "b"},
+				fakeProcessor{name: "c", streamable: true},
+				fakeProcessor{name: "d"},
+			},
+			expectedStreamable: []string{"a"},
+			expectedDeferred:   []string{"b", "c", "d"},
+		},
+		{
+			desc: "first processor not streamable",
+			procs: []processors.Processor{
+				fakeProcessor{name: "a"},
+				fakeProcessor{name: "b", streamable: true},
+			},
+			expectedDeferred: []string{"a", "b"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			streamable, deferred := splitStreamable(test.procs)
+
+			assert.Equal(t, test.expectedStreamable, processorNames(streamable))
+			assert.Equal(t, test.expectedDeferred, processorNames(deferred))
+		})
+	}
+}
+
+func processorNames(procs []processors.Processor) []string {
+	var names []string
+
+	for _, p := range procs {
+		names = append(names, p.Name())
+	}
+
+	return names
+}
@@ -17,14 +17,18 @@ const envDebug = "GL_DEBUG"
 
 const (
 	DebugKeyAutogenExclude     = "autogen_exclude" // Debugs a filter excluding autogenerated source code.
+	DebugKeyBaseline           = "baseline"        // Debugs a filter excluding the issues from a baseline.
 	DebugKeyBinSalt            = "bin_salt"
 	DebugKeyConfigReader       = "config_reader"
+	DebugKeyDaemon             = "daemon" // Debugs the daemon.
 	DebugKeyEmpty              = ""
 	DebugKeyEnabledLinters     = "enabled_linters"
-	DebugKeyEnv                = "env" // Debugs `go env` command.
+	DebugKeyEnv                = "env"         // Debugs `go env` command.
+	DebugKeyEquivalents        = "equivalents" // Debugs a filter merging the issues reported by several linters.
 	DebugKeyExcludeRules       = "exclude_rules"
 	DebugKeyExec               = "exec"
 	DebugKeyFilenameUnadjuster = "filename_unadjuster"
+	DebugKeyFingerprint        = "fingerprint" // Debugs the computation of the issues fingerprints.
 	DebugKeyInvalidIssue       = "invalid_issue"
 	DebugKeyForbidigo          = "forbidigo"
 	DebugKeyGoEnv              = "goenv"
@@ -33,6 +37,7 @@ const (
 	DebugKeyLintersDB          = "lintersdb"
 	DebugKeyLintersOutput      = "linters_output"
 	DebugKeyLoader             = "loader" // Debugs packages loading (including `go/packages` internal debugging).
+	DebugKeyLSP                = "lsp"    // Debugs the language server.
 	DebugKeyMaxFromLinter      = "max_from_linter"
 	DebugKeyMaxSameIssues      = "max_same_issues"
 	DebugKeyPkgCache           = "pkgcache"
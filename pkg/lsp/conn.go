@@ -0,0 +1,133 @@
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/textproto"
+	"strconv"
+	"sync"
+)
+
+const jsonrpcVersion = "2.0"
+
+// message is a JSON-RPC 2.0 request, notification (without ID), or response.
+type message struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+func (m *message) isNotification() bool {
+	return len(m.ID) == 0
+}
+
+type notification struct {
+	JSONRPC string `json:"jsonrpc"`
+	Method  string `json:"method"`
+	Params  any    `json:"params"`
+}
+
+// response must contain either a result or an error.
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result"`
+}
+
+type errorResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   *responseError  `json:"error"`
+}
+
+type responseError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *responseError) Error() string {
+	return fmt.Sprintf("%s (%d)", e.Message, e.Code)
+}
+
+// conn reads and writes the messages with the base protocol of LSP:
+// each message is preceded by a header containing its length.
+type conn struct {
+	r *textproto.Reader
+
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func newConn(r io.Reader, w io.Writer) *conn {
+	return &conn{
+		r: textproto.NewReader(bufio.NewReader(r)),
+		w: w,
+	}
+}
+
+func (c *conn) read() (*message, error) {
+	header, err := c.r.ReadMIMEHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	length, err := strconv.Atoi(header.Get("Content-Length"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid Content-Length: %w", err)
+	}
+
+	data := make([]byte, length)
+
+	_, err = io.ReadFull(c.r.R, data)
+	if err != nil {
+		return nil, fmt.Errorf("can't read message: %w", err)
+	}
+
+	msg := &message{}
+
+	err = json.Unmarshal(data, msg)
+	if err != nil {
+		return nil, &responseError{Code: codeParseError, Message: err.Error()}
+	}
+
+	if msg.JSONRPC != jsonrpcVersion {
+		return nil, &responseError{Code: codeInvalidRequest, Message: fmt.Sprintf("unsupported JSON-RPC version %q", msg.JSONRPC)}
+	}
+
+	return msg, nil
+}
+
+func (c *conn) write(v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_, err = fmt.Fprintf(c.w, "Content-Length: %d\r\n\r\n%s", len(data), data)
+
+	return err
+}
+
+func (c *conn) notify(method string, params any) error {
+	return c.write(notification{JSONRPC: jsonrpcVersion, Method: method, Params: params})
+}
+
+func (c *conn) reply(id json.RawMessage, result any, err error) error {
+	if err == nil {
+		return c.write(response{JSONRPC: jsonrpcVersion, ID: id, Result: result})
+	}
+
+	var respErr *responseError
+	if !errors.As(err, &respErr) {
+		respErr = &responseError{Code: codeInvalidParams, Message: err.Error()}
+	}
+
+	return c.write(errorResponse{JSONRPC: jsonrpcVersion, ID: id, Error: respErr})
+}
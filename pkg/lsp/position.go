@@ -0,0 +1,123 @@
+package lsp
+
+import (
+	"net/url"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// content is the content of a file with the offsets of its lines.
+type content struct {
+	data        []byte
+	lineOffsets []int
+}
+
+func newContent(data []byte) *content {
+	offsets := []int{0}
+	for i, b := range data {
+		if b == '\n' {
+			offsets = append(offsets, i+1)
+		}
+	}
+
+	return &content{data: data, lineOffsets: offsets}
+}
+
+// lineEnd returns the offset of the end of a line (zero-based), without the line ending.
+func (c *content) lineEnd(line int) int {
+	if line+1 >= len(c.lineOffsets) {
+		return len(c.data)
+	}
+
+	end := c.lineOffsets[line+1] - 1
+	if end > c.lineOffsets[line] && c.data[end-1] == '\r' {
+		end--
+	}
+
+	return end
+}
+
+// position converts a byte offset into a position.
+func (c *content) position(offset int) Position {
+	offset = max(0, min(offset, len(c.data)))
+
+	// The index of the first line starting after the offset.
+	line := 0
+	for line+1 < len(c.lineOffsets) && c.lineOffsets[line+1] <= offset {
+		line++
+	}
+
+	return Position{Line: line, Character: utf16Len(c.data[c.lineOffsets[line]:offset])}
+}
+
+// positionFromColumn converts a line and a byte column (one-based, as in [token.Position]) into a position.
+// A column of 0 means the start of the line.
+func (c *content) positionFromColumn(line, column int) Position {
+	if line < 1 || line > len(c.lineOffsets) {
+		return Position{Line: max(0, line-1)}
+	}
+
+	start := c.lineOffsets[line-1]
+	offset := min(start+max(0, column-1), c.lineEnd(line-1))
+
+	return c.position(offset)
+}
+
+// lineEndPosition returns the position of the end of a line (one-based).
+func (c *content) lineEndPosition(line int) Position {
+	if line < 1 || line > len(c.lineOffsets) {
+		return Position{Line: max(0, line-1)}
+	}
+
+	return c.position(c.lineEnd(line - 1))
+}
+
+func utf16Len(b []byte) int {
+	var buf [2]uint16
+
+	var n int
+	for len(b) > 0 {
+		r, size := utf8.DecodeRune(b)
+		n += len(utf16.AppendRune(buf[:0], r))
+		b = b[size:]
+	}
+
+	return n
+}
+
+func uriToPath(uri DocumentURI) (string, error) {
+	u, err := url.Parse(string(uri))
+	if err != nil {
+		return "", err
+	}
+
+	path := u.Path
+
+	// The path of a Windows URI starts with a slash before the drive letter.
+	if runtime.GOOS == "windows" && len(path) > 2 && path[0] == '/' && path[2] == ':' {
+		path = path[1:]
+	}
+
+	return filepath.FromSlash(path), nil
+}
+
+func pathToURI(path string) DocumentURI {
+	path = filepath.ToSlash(path)
+
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	return DocumentURI((&url.URL{Scheme: "file", Path: path}).String())
+}
+
+func rangesOverlap(a, b Range) bool {
+	return !positionBefore(a.End, b.Start) && !positionBefore(b.End, a.Start)
+}
+
+func positionBefore(a, b Position) bool {
+	return a.Line < b.Line || (a.Line == b.Line && a.Character < b.Character)
+}
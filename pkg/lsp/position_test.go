@@ -0,0 +1,124 @@
+package lsp
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_content_position(t *testing.T) {
+	c := newContent([]byte("package a\r\n\nvar s = \"héllo 😀\" // x\n"))
+
+	testCases := []struct {
+		desc     string
+		offset   int
+		expected Position
+	}{
+		{
+			desc:     "start",
+			offset:   0,
+			expected: Position{Line: 0, Character: 0},
+		},
+		{
+			desc:     "empty line",
+			offset:   11,
+			expected: Position{Line: 1, Character: 0},
+		},
+		{
+			desc:     "after multi-byte runes",
+			offset:   33,
+			expected: Position{Line: 2, Character: 18},
+		},
+		{
+			desc:     "out of range",
+			offset:   1000,
+			expected: Position{Line: 3, Character: 0},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, c.position(test.offset))
+		})
+	}
+}
+
+func Test_content_positionFromColumn(t *testing.T) {
+	c := newContent([]byte("package a\r\n\nvar s = \"héllo\"\n"))
+
+	testCases := []struct {
+		desc     string
+		line     int
+		column   int
+		expected Position
+	}{
+		{
+			desc:     "no column",
+			line:     1,
+			column:   0,
+			expected: Position{Line: 0, Character: 0},
+		},
+		{
+			desc:     "column after a multi-byte rune",
+			line:     3,
+			column:   14,
+			expected: Position{Line: 2, Character: 12},
+		},
+		{
+			desc:     "column after the end of the line",
+			line:     1,
+			column:   100,
+			expected: Position{Line: 0, Character: 9},
+		},
+		{
+			desc:     "line out of range",
+			line:     10,
+			column:   1,
+			expected: Position{Line: 9, Character: 0},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, c.positionFromColumn(test.line, test.column))
+		})
+	}
+}
+
+func Test_content_lineEndPosition(t *testing.T) {
+	c := newContent([]byte("package a\r\n\nvar s = 1"))
+
+	assert.Equal(t, Position{Line: 0, Character: 9}, c.lineEndPosition(1))
+	assert.Equal(t, Position{Line: 1, Character: 0}, c.lineEndPosition(2))
+	assert.Equal(t, Position{Line: 2, Character: 9}, c.lineEndPosition(3))
+}
+
+func Test_rangesOverlap(t *testing.T) {
+	a := Range{Start: Position{Line: 1, Character: 2}, End: Position{Line: 1, Character: 8}}
+
+	assert.True(t, rangesOverlap(a, Range{Start: Position{Line: 1, Character: 5}, End: Position{Line: 1, Character: 5}}))
+	assert.True(t, rangesOverlap(a, Range{Start: Position{Line: 0}, End: Position{Line: 3}}))
+	assert.False(t, rangesOverlap(a, Range{Start: Position{Line: 1, Character: 9}, End: Position{Line: 2}}))
+	assert.False(t, rangesOverlap(a, Range{Start: Position{Line: 0}, End: Position{Line: 1, Character: 1}}))
+}
+
+func Test_pathToURI(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "my project", "a.go")
+
+	uri := pathToURI(path)
+
+	assert.True(t, strings.HasPrefix(string(uri), "file:///"))
+	assert.True(t, strings.HasSuffix(string(uri), "/my%20project/a.go"))
+
+	got, err := uriToPath(uri)
+	require.NoError(t, err)
+
+	assert.Equal(t, path, got)
+}
@@ -0,0 +1,682 @@
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+	"github.com/golangci/golangci-lint/pkg/result/processors"
+)
+
+const diagnosticSource = "golangci-lint"
+
+// debounceDelay is the delay without changes before linting: it avoids linting on each keystroke.
+const debounceDelay = 300 * time.Millisecond
+
+// Linter lints the packages of directories.
+type Linter interface {
+	// Lint lints the packages of the directories (absolute paths), and their reverse dependencies.
+	// The overlay contains the content of the unsaved files (absolute path -> content).
+	// The changed files are the files modified on the disk since the previous call.
+	// It returns the issues, and the linted directories (the directories and the directories of the reverse dependencies).
+	Lint(ctx context.Context, dirs []string, overlay map[string][]byte, changed []string) (issues []result.Issue, linted []string, err error)
+}
+
+type document struct {
+	version int32
+	text    []byte
+}
+
+// lintedFile contains the results of the last analysis of a file.
+type lintedFile struct {
+	uri DocumentURI
+
+	// version of the document when linted, nil if the file was not opened.
+	version *int32
+
+	content     *content
+	issues      []result.Issue
+	diagnostics []Diagnostic
+}
+
+// Server is a language server publishing the issues as diagnostics,
+// and the fixes of the issues as code actions.
+//
+// The packages are linted when a document is opened, changed, or saved:
+// only the packages of the modified files, and their reverse dependencies, are linted again.
+type Server struct {
+	log     logutils.Log
+	linter  Linter
+	version string
+
+	debounce time.Duration
+
+	conn *conn
+
+	mu sync.Mutex
+
+	initialized bool
+	shutdown    bool
+
+	// open documents: absolute path -> document.
+	documents map[string]*document
+	// directories to lint.
+	pending map[string]bool
+	// files modified on the disk.
+	changed map[string]bool
+	// last results: absolute path -> results.
+	files map[string]*lintedFile
+
+	trigger chan struct{}
+}
+
+func NewServer(log logutils.Log, linter Linter, version string) *Server {
+	return &Server{
+		log:       log,
+		linter:    linter,
+		version:   version,
+		debounce:  debounceDelay,
+		documents: map[string]*document{},
+		pending:   map[string]bool{},
+		changed:   map[string]bool{},
+		files:     map[string]*lintedFile{},
+		trigger:   make(chan struct{}, 1),
+	}
+}
+
+// Run serves the requests until the client exits.
+func (s *Server) Run(ctx context.Context, r io.Reader, w io.Writer) error {
+	s.conn = newConn(r, w)
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		s.lintLoop(ctx)
+	}()
+
+	for {
+		msg, err := s.conn.read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			var respErr *responseError
+			if errors.As(err, &respErr) {
+				_ = s.conn.reply(json.RawMessage("null"), nil, respErr)
+				continue
+			}
+
+			return err
+		}
+
+		if msg.Method == methodExit {
+			if !s.isShutdown() {
+				return errors.New("exit without shutdown")
+			}
+
+			return nil
+		}
+
+		res, err := s.handle(msg)
+
+		if msg.isNotification() {
+			if err != nil {
+				s.log.Warnf("Failed to handle %s: %v", msg.Method, err)
+			}
+
+			continue
+		}
+
+		err = s.conn.reply(msg.ID, res, err)
+		if err != nil {
+			return fmt.Errorf("can't reply to %s: %w", msg.Method, err)
+		}
+	}
+}
+
+func (s *Server) handle(msg *message) (any, error) {
+	if msg.Method == methodInitialize {
+		return s.initialize(), nil
+	}
+
+	s.mu.Lock()
+	initialized := s.initialized
+	s.mu.Unlock()
+
+	if !initialized {
+		return nil, &responseError{Code: codeServerNotInitialized, Message: "server not initialized"}
+	}
+
+	switch msg.Method {
+	case methodInitialized:
+		return nil, nil
+
+	case methodShutdown:
+		s.mu.Lock()
+		s.shutdown = true
+		s.mu.Unlock()
+
+		return nil, nil
+
+	case methodDidOpen:
+		var params DidOpenTextDocumentParams
+		return nil, handleParams(msg, &params, func() error {
+			return s.didOpen(&params)
+		})
+
+	case methodDidChange:
+		var params DidChangeTextDocumentParams
+		return nil, handleParams(msg, &params, func() error {
+			return s.didChange(&params)
+		})
+
+	case methodDidSave:
+		var params DidSaveTextDocumentParams
+		return nil, handleParams(msg, &params, func() error {
+			return s.didSave(&params)
+		})
+
+	case methodDidClose:
+		var params DidCloseTextDocumentParams
+		return nil, handleParams(msg, &params, func() error {
+			return s.didClose(&params)
+		})
+
+	case methodDidChangeWatchedFile:
+		var params DidChangeWatchedFilesParams
+		return nil, handleParams(msg, &params, func() error {
+			return s.didChangeWatchedFiles(&params)
+		})
+
+	case methodCodeAction:
+		var params CodeActionParams
+
+		var actions []CodeAction
+		err := handleParams(msg, &params, func() error {
+			var err error
+			actions, err = s.codeActions(&params)
+			return err
+		})
+
+		return actions, err
+
+	default:
+		if msg.isNotification() {
+			// The unknown notifications are ignored (ex: `$/cancelRequest`, `workspace/didChangeConfiguration`).
+			return nil, nil
+		}
+
+		return nil, &responseError{Code: codeMethodNotFound, Message: fmt.Sprintf("method not found: %s", msg.Method)}
+	}
+}
+
+func handleParams(msg *message, params any, fn func() error) error {
+	err := json.Unmarshal(msg.Params, params)
+	if err != nil {
+		return &responseError{Code: codeInvalidParams, Message: err.Error()}
+	}
+
+	return fn()
+}
+
+func (s *Server) initialize() *InitializeResult {
+	s.mu.Lock()
+	s.initialized = true
+	s.mu.Unlock()
+
+	return &InitializeResult{
+		Capabilities: ServerCapabilities{
+			TextDocumentSync: TextDocumentSyncOptions{
+				OpenClose: true,
+				Change:    textDocumentSyncKindFull,
+				Save:      &SaveOptions{},
+			},
+			CodeActionProvider: CodeActionOptions{CodeActionKinds: []string{codeActionKindQuickFix}},
+		},
+		ServerInfo: &ServerInfo{Name: diagnosticSource, Version: s.version},
+	}
+}
+
+func (s *Server) didOpen(params *DidOpenTextDocumentParams) error {
+	path, err := uriToPath(params.TextDocument.URI)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.documents[path] = &document{version: params.TextDocument.Version, text: []byte(params.TextDocument.Text)}
+	s.mu.Unlock()
+
+	s.schedule(path)
+
+	return nil
+}
+
+func (s *Server) didChange(params *DidChangeTextDocumentParams) error {
+	if len(params.ContentChanges) == 0 {
+		return nil
+	}
+
+	path, err := uriToPath(params.TextDocument.URI)
+	if err != nil {
+		return err
+	}
+
+	// Only the full synchronization is supported: the last change contains the whole content.
+	text := params.ContentChanges[len(params.ContentChanges)-1].Text
+
+	s.mu.Lock()
+	s.documents[path] = &document{version: params.TextDocument.Version, text: []byte(text)}
+	s.mu.Unlock()
+
+	s.schedule(path)
+
+	return nil
+}
+
+func (s *Server) didSave(params *DidSaveTextDocumentParams) error {
+	path, err := uriToPath(params.TextDocument.URI)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.changed[path] = true
+	s.mu.Unlock()
+
+	s.schedule(path)
+
+	return nil
+}
+
+func (s *Server) didClose(params *DidCloseTextDocumentParams) error {
+	path, err := uriToPath(params.TextDocument.URI)
+	if err != nil {
+		return err
+	}
+
+	// The content of the disk is used again: it can be different if the document was not saved.
+	s.mu.Lock()
+	delete(s.documents, path)
+	s.changed[path] = true
+	s.mu.Unlock()
+
+	s.schedule(path)
+
+	return nil
+}
+
+func (s *Server) didChangeWatchedFiles(params *DidChangeWatchedFilesParams) error {
+	for _, change := range params.Changes {
+		path, err := uriToPath(change.URI)
+		if err != nil {
+			return err
+		}
+
+		s.mu.Lock()
+		s.changed[path] = true
+		s.mu.Unlock()
+
+		if strings.HasSuffix(path, ".go") {
+			s.schedule(path)
+		}
+	}
+
+	return nil
+}
+
+// schedule requests the analysis of the package of a file.
+func (s *Server) schedule(path string) {
+	s.mu.Lock()
+	s.pending[filepath.Dir(path)] = true
+	s.mu.Unlock()
+
+	select {
+	case s.trigger <- struct{}{}:
+	default:
+	}
+}
+
+func (s *Server) lintLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.trigger:
+		}
+
+		// Waits for the end of a burst of changes.
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(s.debounce):
+		}
+
+		s.lint(ctx)
+	}
+}
+
+func (s *Server) lint(ctx context.Context) {
+	s.mu.Lock()
+
+	dirs := maps.Keys(s.pending)
+	sort.Strings(dirs)
+
+	changed := maps.Keys(s.changed)
+	sort.Strings(changed)
+
+	overlay := map[string][]byte{}
+	versions := map[string]int32{}
+
+	for path, doc := range s.documents {
+		overlay[path] = doc.text
+		versions[path] = doc.version
+	}
+
+	s.pending = map[string]bool{}
+	s.changed = map[string]bool{}
+
+	s.mu.Unlock()
+
+	if len(dirs) == 0 {
+		return
+	}
+
+	s.log.Infof("Linting %s", strings.Join(dirs, ", "))
+
+	issues, linted, err := s.linter.Lint(ctx, dirs, overlay, changed)
+	if err != nil {
+		s.logError(fmt.Sprintf("Failed to lint %s: %v", strings.Join(dirs, ", "), err))
+		return
+	}
+
+	s.publish(linted, issues, overlay, versions)
+}
+
+func (s *Server) publish(dirs []string, issues []result.Issue, overlay map[string][]byte, versions map[string]int32) {
+	byFile := map[string][]result.Issue{}
+
+	for i := range issues {
+		path, err := filepath.Abs(issues[i].FilePath())
+		if err != nil {
+			s.log.Warnf("Can't get the absolute path of %s: %v", issues[i].FilePath(), err)
+			continue
+		}
+
+		byFile[path] = append(byFile[path], issues[i])
+	}
+
+	// The diagnostics of the files without issues must be cleared.
+	s.mu.Lock()
+	for path := range s.files {
+		if _, ok := byFile[path]; !ok && containsDir(dirs, filepath.Dir(path)) {
+			byFile[path] = nil
+		}
+	}
+	s.mu.Unlock()
+
+	paths := maps.Keys(byFile)
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		lf := newLintedFile(path, byFile[path], overlay, versions)
+
+		s.mu.Lock()
+		if len(lf.issues) == 0 {
+			delete(s.files, path)
+		} else {
+			s.files[path] = lf
+		}
+		s.mu.Unlock()
+
+		err := s.conn.notify(methodPublishDiagnostics, PublishDiagnosticsParams{
+			URI:         lf.uri,
+			Version:     lf.version,
+			Diagnostics: lf.diagnostics,
+		})
+		if err != nil {
+			s.log.Warnf("Can't publish the diagnostics of %s: %v", path, err)
+		}
+	}
+}
+
+func newLintedFile(path string, issues []result.Issue, overlay map[string][]byte, versions map[string]int32) *lintedFile {
+	data, ok := overlay[path]
+	if !ok {
+		// The positions are approximated if the file can't be read.
+		data, _ = os.ReadFile(path)
+	}
+
+	lf := &lintedFile{
+		uri:         pathToURI(path),
+		content:     newContent(data),
+		issues:      issues,
+		diagnostics: []Diagnostic{},
+	}
+
+	if version, ok := versions[path]; ok {
+		lf.version = &version
+	}
+
+	for i := range issues {
+		lf.diagnostics = append(lf.diagnostics, toDiagnostic(lf.content, &issues[i]))
+	}
+
+	return lf
+}
+
+func (s *Server) codeActions(params *CodeActionParams) ([]CodeAction, error) {
+	path, err := uriToPath(params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	lf := s.files[path]
+	doc := s.documents[path]
+	s.mu.Unlock()
+
+	actions := []CodeAction{}
+
+	// The edits are computed from the linted content: they can't be applied on a modified document.
+	if lf == nil || (doc != nil && (lf.version == nil || *lf.version != doc.version)) {
+		return actions, nil
+	}
+
+	for i := range lf.issues {
+		if !rangesOverlap(lf.diagnostics[i].Range, params.Range) {
+			continue
+		}
+
+		actions = append(actions, s.buildCodeActions(lf, &lf.issues[i], &lf.diagnostics[i])...)
+	}
+
+	return actions, nil
+}
+
+func (s *Server) buildCodeActions(lf *lintedFile, issue *result.Issue, diagnostic *Diagnostic) []CodeAction {
+	var actions []CodeAction
+
+	for _, fix := range issue.SuggestedFixes {
+		edit, err := s.buildWorkspaceEdit(lf, fix.TextEdits)
+		if err != nil {
+			s.log.Infof("Can't build the code action of %s: %v", issue.Description(), err)
+			continue
+		}
+
+		title := fix.Message
+		if title == "" {
+			title = issue.Text
+		}
+
+		actions = append(actions, CodeAction{
+			Title:       fmt.Sprintf("%s: %s", issue.FromLinter, title),
+			Kind:        codeActionKindQuickFix,
+			Diagnostics: []Diagnostic{*diagnostic},
+			Edit:        edit,
+		})
+	}
+
+	if len(issue.SuggestedFixes) > 0 || issue.Replacement == nil {
+		return actions
+	}
+
+	textEdit, err := processors.ReplacementToEdit(issue, lf.content.data)
+	if err != nil {
+		s.log.Infof("Can't build the code action of %s: %v", issue.Description(), err)
+		return nil
+	}
+
+	return []CodeAction{{
+		Title:       fmt.Sprintf("%s: apply the suggested fix", issue.FromLinter),
+		Kind:        codeActionKindQuickFix,
+		Diagnostics: []Diagnostic{*diagnostic},
+		IsPreferred: true,
+		Edit: &WorkspaceEdit{Changes: map[DocumentURI][]TextEdit{
+			lf.uri: {toTextEdit(lf.content, textEdit)},
+		}},
+	}}
+}
+
+func (s *Server) buildWorkspaceEdit(lf *lintedFile, textEdits []result.TextEdit) (*WorkspaceEdit, error) {
+	edit := &WorkspaceEdit{Changes: map[DocumentURI][]TextEdit{}}
+
+	contents := map[string]*content{}
+
+	for _, textEdit := range textEdits {
+		path, err := filepath.Abs(textEdit.Filename)
+		if err != nil {
+			return nil, err
+		}
+
+		c, ok := contents[path]
+		if !ok {
+			c, err = s.fileContent(lf, path)
+			if err != nil {
+				return nil, err
+			}
+
+			contents[path] = c
+		}
+
+		uri := pathToURI(path)
+		edit.Changes[uri] = append(edit.Changes[uri], toTextEdit(c, textEdit))
+	}
+
+	return edit, nil
+}
+
+func (s *Server) fileContent(lf *lintedFile, path string) (*content, error) {
+	if lf.uri == pathToURI(path) {
+		return lf.content, nil
+	}
+
+	s.mu.Lock()
+	doc := s.documents[path]
+	s.mu.Unlock()
+
+	if doc != nil {
+		return newContent(doc.text), nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return newContent(data), nil
+}
+
+// logError logs an error and displays it in the client.
+func (s *Server) logError(msg string) {
+	s.log.Warnf("%s", msg)
+
+	err := s.conn.notify(methodLogMessage, LogMessageParams{Type: messageTypeError, Message: msg})
+	if err != nil {
+		s.log.Warnf("Can't send a message: %v", err)
+	}
+}
+
+func (s *Server) isShutdown() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.shutdown
+}
+
+func toDiagnostic(c *content, issue *result.Issue) Diagnostic {
+	start := c.positionFromColumn(issue.Line(), issue.Column())
+
+	end := start
+	if issue.Column() == 0 {
+		end = c.lineEndPosition(issue.Line())
+	}
+
+	if rng := issue.GetLineRange(); rng.To > issue.Line() {
+		end = c.lineEndPosition(rng.To)
+	}
+
+	switch {
+	case issue.EndColumn() > 0:
+		end = c.positionFromColumn(issue.EndLine(), issue.EndColumn())
+	case issue.EndLine() > issue.Line():
+		end = c.lineEndPosition(issue.EndLine())
+	}
+
+	return Diagnostic{
+		Range:    Range{Start: start, End: end},
+		Severity: toDiagnosticSeverity(issue.Severity),
+		Code:     issue.Rule(),
+		Source:   diagnosticSource,
+		Message:  issue.Text,
+	}
+}
+
+func toDiagnosticSeverity(severity string) int {
+	switch strings.ToLower(severity) {
+	case "error":
+		return diagnosticSeverityError
+	case "info", "information":
+		return diagnosticSeverityInformation
+	case "hint":
+		return diagnosticSeverityHint
+	default:
+		return diagnosticSeverityWarning
+	}
+}
+
+func toTextEdit(c *content, edit result.TextEdit) TextEdit {
+	return TextEdit{
+		Range:   Range{Start: c.position(edit.Start), End: c.position(edit.End)},
+		NewText: edit.NewText,
+	}
+}
+
+func containsDir(dirs []string, dir string) bool {
+	for _, d := range dirs {
+		if d == dir {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,343 @@
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type fakeLinter struct {
+	mu    sync.Mutex
+	calls [][]string
+
+	// directory -> directories of the reverse dependencies.
+	importers map[string][]string
+
+	issues func(overlay map[string][]byte) []result.Issue
+}
+
+func (f *fakeLinter) Lint(_ context.Context, dirs []string, overlay map[string][]byte, _ []string) ([]result.Issue, []string, error) {
+	f.mu.Lock()
+	f.calls = append(f.calls, dirs)
+	f.mu.Unlock()
+
+	linted := slices.Clone(dirs)
+	for _, dir := range dirs {
+		linted = append(linted, f.importers[dir]...)
+	}
+
+	return f.issues(overlay), linted, nil
+}
+
+// clientMessage is a message received by the client.
+type clientMessage struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	Result json.RawMessage `json:"result"`
+	Error  *responseError  `json:"error"`
+}
+
+type testClient struct {
+	t    *testing.T
+	conn *conn
+}
+
+func (c *testClient) request(id int, method string, params any) {
+	c.t.Helper()
+
+	data, err := json.Marshal(params)
+	require.NoError(c.t, err)
+
+	err = c.conn.write(message{JSONRPC: jsonrpcVersion, ID: json.RawMessage(strconv.Itoa(id)), Method: method, Params: data})
+	require.NoError(c.t, err)
+}
+
+func (c *testClient) notify(method string, params any) {
+	c.t.Helper()
+
+	require.NoError(c.t, c.conn.notify(method, params))
+}
+
+func (c *testClient) receive(v any) *clientMessage {
+	c.t.Helper()
+
+	header, err := c.conn.r.ReadMIMEHeader()
+	require.NoError(c.t, err)
+
+	length, err := strconv.Atoi(header.Get("Content-Length"))
+	require.NoError(c.t, err)
+
+	data := make([]byte, length)
+
+	_, err = io.ReadFull(c.conn.r.R, data)
+	require.NoError(c.t, err)
+
+	msg := &clientMessage{}
+	require.NoError(c.t, json.Unmarshal(data, msg))
+
+	if v != nil {
+		raw := msg.Result
+		if msg.Method != "" {
+			raw = msg.Params
+		}
+
+		require.NoError(c.t, json.Unmarshal(raw, v))
+	}
+
+	return msg
+}
+
+// startServer starts and initializes a server.
+func startServer(t *testing.T, linter Linter) (client *testClient, done <-chan error) {
+	t.Helper()
+
+	server := NewServer(logutils.NewStderrLog(logutils.DebugKeyEmpty), linter, "test")
+	server.debounce = 0
+
+	clientReader, serverWriter := io.Pipe()
+	serverReader, clientWriter := io.Pipe()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- server.Run(context.Background(), serverReader, serverWriter)
+	}()
+
+	client = &testClient{t: t, conn: newConn(clientReader, clientWriter)}
+
+	client.request(1, methodInitialize, InitializeParams{})
+
+	var initResult InitializeResult
+	client.receive(&initResult)
+
+	assert.Equal(t, textDocumentSyncKindFull, initResult.Capabilities.TextDocumentSync.Change)
+	assert.Equal(t, []string{codeActionKindQuickFix}, initResult.Capabilities.CodeActionProvider.CodeActionKinds)
+
+	client.notify(methodInitialized, struct{}{})
+
+	return client, errCh
+}
+
+func TestServer(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "a.go")
+
+	err := os.WriteFile(path, []byte("package a\n"), 0o600)
+	require.NoError(t, err)
+
+	text := "package a\n\nfunc f() {\n\tfmt.Println( \"x\")\n}\n"
+
+	linter := &fakeLinter{
+		issues: func(overlay map[string][]byte) []result.Issue {
+			if string(overlay[path]) != text {
+				return nil
+			}
+
+			return []result.Issue{{
+				FromLinter: "gofmt",
+				Text:       "File is not `gofmt`-ed",
+				Severity:   "error",
+				Pos:        token.Position{Filename: path, Line: 4, Column: 2},
+				Replacement: &result.Replacement{
+					Inline: &result.InlineFix{StartCol: 13, Length: 1, NewString: ""},
+				},
+			}}
+		},
+	}
+
+	client, done := startServer(t, linter)
+
+	// Diagnostics of an unsaved document.
+	uri := pathToURI(path)
+
+	client.notify(methodDidOpen, DidOpenTextDocumentParams{
+		TextDocument: TextDocumentItem{URI: uri, LanguageID: "go", Version: 1, Text: text},
+	})
+
+	var diagnostics PublishDiagnosticsParams
+	msg := client.receive(&diagnostics)
+
+	assert.Equal(t, methodPublishDiagnostics, msg.Method)
+	assert.Equal(t, uri, diagnostics.URI)
+	require.NotNil(t, diagnostics.Version)
+	assert.EqualValues(t, 1, *diagnostics.Version)
+
+	expectedDiagnostic := Diagnostic{
+		Range:    Range{Start: Position{Line: 3, Character: 1}, End: Position{Line: 3, Character: 1}},
+		Severity: diagnosticSeverityError,
+		Code:     "gofmt",
+		Source:   "golangci-lint",
+		Message:  "File is not `gofmt`-ed",
+	}
+
+	assert.Equal(t, []Diagnostic{expectedDiagnostic}, diagnostics.Diagnostics)
+
+	// Code action built from the replacement.
+	client.request(2, methodCodeAction, CodeActionParams{
+		TextDocument: TextDocumentIdentifier{URI: uri},
+		Range:        Range{Start: Position{Line: 3, Character: 0}, End: Position{Line: 3, Character: 5}},
+	})
+
+	var actions []CodeAction
+	client.receive(&actions)
+
+	expectedActions := []CodeAction{{
+		Title:       "gofmt: apply the suggested fix",
+		Kind:        codeActionKindQuickFix,
+		Diagnostics: []Diagnostic{expectedDiagnostic},
+		IsPreferred: true,
+		Edit: &WorkspaceEdit{Changes: map[DocumentURI][]TextEdit{
+			uri: {{Range: Range{Start: Position{Line: 3, Character: 13}, End: Position{Line: 3, Character: 14}}}},
+		}},
+	}}
+
+	assert.Equal(t, expectedActions, actions)
+
+	// The fix clears the diagnostics.
+	client.notify(methodDidChange, DidChangeTextDocumentParams{
+		TextDocument:   VersionedTextDocumentIdentifier{URI: uri, Version: 2},
+		ContentChanges: []TextDocumentContentChangeEvent{{Text: "package a\n"}},
+	})
+
+	diagnostics = PublishDiagnosticsParams{}
+	client.receive(&diagnostics)
+
+	assert.Equal(t, uri, diagnostics.URI)
+	assert.Empty(t, diagnostics.Diagnostics)
+
+	// Unknown request.
+	client.request(3, "textDocument/hover", struct{}{})
+
+	msg = client.receive(nil)
+	require.NotNil(t, msg.Error)
+	assert.Equal(t, codeMethodNotFound, msg.Error.Code)
+
+	// Shutdown.
+	client.request(4, methodShutdown, nil)
+	client.receive(nil)
+
+	client.notify(methodExit, nil)
+
+	require.NoError(t, <-done)
+
+	linter.mu.Lock()
+	defer linter.mu.Unlock()
+
+	assert.Equal(t, [][]string{{dir}, {dir}}, linter.calls)
+}
+
+func TestServer_reverseDependencies(t *testing.T) {
+	dir := t.TempDir()
+
+	pathA := filepath.Join(dir, "a", "a.go")
+	pathB := filepath.Join(dir, "b", "b.go")
+
+	text := "package a\n\nfunc A() error { return nil }\n"
+
+	linter := &fakeLinter{
+		importers: map[string][]string{filepath.Dir(pathA): {filepath.Dir(pathB)}},
+		issues: func(overlay map[string][]byte) []result.Issue {
+			if string(overlay[pathA]) != text {
+				return nil
+			}
+
+			// The modification of the dependency adds an issue inside the importer.
+			return []result.Issue{{
+				FromLinter: "errcheck",
+				Text:       "Error return value of `a.A` is not checked",
+				Pos:        token.Position{Filename: pathB, Line: 5, Column: 2},
+			}}
+		},
+	}
+
+	client, done := startServer(t, linter)
+
+	uriA := pathToURI(pathA)
+
+	client.notify(methodDidOpen, DidOpenTextDocumentParams{
+		TextDocument: TextDocumentItem{URI: uriA, LanguageID: "go", Version: 1, Text: text},
+	})
+
+	var diagnostics PublishDiagnosticsParams
+	client.receive(&diagnostics)
+
+	assert.Equal(t, pathToURI(pathB), diagnostics.URI)
+	assert.Len(t, diagnostics.Diagnostics, 1)
+
+	// The diagnostics of the importer are cleared.
+	client.notify(methodDidChange, DidChangeTextDocumentParams{
+		TextDocument:   VersionedTextDocumentIdentifier{URI: uriA, Version: 2},
+		ContentChanges: []TextDocumentContentChangeEvent{{Text: "package a\n\nfunc A() {}\n"}},
+	})
+
+	diagnostics = PublishDiagnosticsParams{}
+	client.receive(&diagnostics)
+
+	assert.Equal(t, pathToURI(pathB), diagnostics.URI)
+	assert.Empty(t, diagnostics.Diagnostics)
+
+	client.request(2, methodShutdown, nil)
+	client.receive(nil)
+
+	client.notify(methodExit, nil)
+
+	require.NoError(t, <-done)
+}
+
+func Test_toDiagnostic(t *testing.T) {
+	c := newContent([]byte("package a\n\nvar s = \"héllo\"\n\nfunc f() {\n}\n"))
+
+	testCases := []struct {
+		desc     string
+		issue    result.Issue
+		expected Range
+	}{
+		{
+			desc:     "position",
+			issue:    result.Issue{Pos: token.Position{Line: 3, Column: 5}},
+			expected: Range{Start: Position{Line: 2, Character: 4}, End: Position{Line: 2, Character: 4}},
+		},
+		{
+			desc:     "no column",
+			issue:    result.Issue{Pos: token.Position{Line: 3}},
+			expected: Range{Start: Position{Line: 2, Character: 0}, End: Position{Line: 2, Character: 15}},
+		},
+		{
+			desc: "end column",
+			issue: result.Issue{
+				Pos: token.Position{Line: 3, Column: 9},
+				End: &result.Position{Line: 3, Column: 17},
+			},
+			expected: Range{Start: Position{Line: 2, Character: 8}, End: Position{Line: 2, Character: 15}},
+		},
+		{
+			desc: "end line",
+			issue: result.Issue{
+				Pos: token.Position{Line: 5, Column: 1},
+				End: &result.Position{Line: 6},
+			},
+			expected: Range{Start: Position{Line: 4, Character: 0}, End: Position{Line: 5, Character: 1}},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, toDiagnostic(c, &test.issue).Range)
+		})
+	}
+}
@@ -68,7 +68,7 @@ func (p Checkstyle) Print(issues []result.Issue) error {
 			Column:   issue.Column(),
 			Line:     issue.Line(),
 			Message:  issue.Text,
-			Source:   issue.FromLinter,
+			Source:   issue.Rule(),
 			Severity: severity,
 		}
 
@@ -15,12 +15,14 @@ const defaultCodeClimateSeverity = "critical"
 // https://docs.gitlab.com/ee/user/project/merge_requests/code_quality.html
 type CodeClimateIssue struct {
 	Description string `json:"description"`
+	CheckName   string `json:"check_name"`
 	Severity    string `json:"severity,omitempty"`
 	Fingerprint string `json:"fingerprint"`
 	Location    struct {
 		Path  string `json:"path"`
 		Lines struct {
 			Begin int `json:"begin"`
+			End   int `json:"end,omitempty"`
 		} `json:"lines"`
 	} `json:"location"`
 }
@@ -39,9 +41,15 @@ func (p CodeClimate) Print(issues []result.Issue) error {
 		issue := &issues[i]
 		codeClimateIssue := CodeClimateIssue{}
 		codeClimateIssue.Description = issue.Description()
+		codeClimateIssue.CheckName = issue.Rule()
 		codeClimateIssue.Location.Path = issue.Pos.Filename
 		codeClimateIssue.Location.Lines.Begin = issue.Pos.Line
-		codeClimateIssue.Fingerprint = issue.Fingerprint()
+
+		if end := max(issue.EndLine(), issue.GetLineRange().To); end > issue.Pos.Line {
+			codeClimateIssue.Location.Lines.End = end
+		}
+
+		codeClimateIssue.Fingerprint = issue.FingerprintID
 		codeClimateIssue.Severity = defaultCodeClimateSeverity
 
 		if issue.Severity != "" {
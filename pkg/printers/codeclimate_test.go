@@ -14,9 +14,10 @@ import (
 func TestCodeClimate_Print(t *testing.T) {
 	issues := []result.Issue{
 		{
-			FromLinter: "linter-a",
-			Severity:   "warning",
-			Text:       "some issue",
+			FromLinter:    "linter-a",
+			Severity:      "warning",
+			Text:          "some issue",
+			FingerprintID: "fa",
 			Pos: token.Position{
 				Filename: "path/to/filea.go",
 				Offset:   2,
@@ -25,9 +26,11 @@ func TestCodeClimate_Print(t *testing.T) {
 			},
 		},
 		{
-			FromLinter: "linter-b",
-			Severity:   "error",
-			Text:       "another issue",
+			FromLinter:    "linter-b",
+			Severity:      "error",
+			Text:          "another issue",
+			RuleID:        "rule-b",
+			FingerprintID: "fb",
 			SourceLines: []string{
 				"func foo() {",
 				"\tfmt.Println(\"bar\")",
@@ -39,10 +42,16 @@ func TestCodeClimate_Print(t *testing.T) {
 				Line:     300,
 				Column:   9,
 			},
+			End: &result.Position{
+				Offset: 40,
+				Line:   302,
+				Column: 2,
+			},
 		},
 		{
-			FromLinter: "linter-c",
-			Text:       "issue c",
+			FromLinter:    "linter-c",
+			Text:          "issue c",
+			FingerprintID: "fc",
 			SourceLines: []string{
 				"func foo() {",
 				"\tfmt.Println(\"ccc\")",
@@ -63,7 +72,7 @@ func TestCodeClimate_Print(t *testing.T) {
 	err := printer.Print(issues)
 	require.NoError(t, err)
 
-	expected := `[{"description":"linter-a: some issue","severity":"warning","fingerprint":"BA73C5DF4A6FD8462FFF1D3140235777","location":{"path":"path/to/filea.go","lines":{"begin":10}}},{"description":"linter-b: another issue","severity":"error","fingerprint":"0777B4FE60242BD8B2E9B7E92C4B9521","location":{"path":"path/to/fileb.go","lines":{"begin":300}}},{"description":"linter-c: issue c","severity":"critical","fingerprint":"BEE6E9FBB6BFA4B7DB9FB036697FB036","location":{"path":"path/to/filec.go","lines":{"begin":200}}}]
+	expected := `[{"description":"linter-a: some issue","check_name":"linter-a","severity":"warning","fingerprint":"fa","location":{"path":"path/to/filea.go","lines":{"begin":10}}},{"description":"linter-b: another issue","check_name":"linter-b/rule-b","severity":"error","fingerprint":"fb","location":{"path":"path/to/fileb.go","lines":{"begin":300,"end":302}}},{"description":"linter-c: issue c","check_name":"linter-c","severity":"critical","fingerprint":"fc","location":{"path":"path/to/filec.go","lines":{"begin":200}}}]
 `
 
 	assert.Equal(t, expected, buf.String())
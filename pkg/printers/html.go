@@ -1,125 +1,185 @@
 package printers
 
 import (
+	"cmp"
 	"fmt"
 	"html/template"
 	"io"
+	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
+// templateContent is a self-contained report: the styles and the scripts are inlined,
+// and the report is readable without JavaScript (the filters need it).
 const templateContent = `<!doctype html>
 <html lang="en">
 <head>
     <meta charset="utf-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1">
     <title>golangci-lint</title>
-    <link rel="shortcut icon" type="image/png" href="https://golangci-lint.run/favicon-32x32.png">
-    <link rel="stylesheet" href="https://cdnjs.cloudflare.com/ajax/libs/bulma/0.9.2/css/bulma.min.css"
-          integrity="sha512-byErQdWdTqREz6DLAA9pCnLbdoGGhXfU6gm1c8bkf7F51JVmUBlayGe2A31VpXWQP+eiJ3ilTAZHCR3vmMyybA=="
-          crossorigin="anonymous" referrerpolicy="no-referrer"/>
-    <link rel="stylesheet" href="https://cdnjs.cloudflare.com/ajax/libs/highlight.js/10.7.2/styles/default.min.css"
-          integrity="sha512-kZqGbhf9JTB4bVJ0G8HCkqmaPcRgo88F0dneK30yku5Y/dep7CZfCnNml2Je/sY4lBoqoksXz4PtVXS4GHSUzQ=="
-          crossorigin="anonymous" referrerpolicy="no-referrer"/>
-    <script type="text/javascript" src="https://cdnjs.cloudflare.com/ajax/libs/highlight.js/10.7.2/highlight.min.js"
-            integrity="sha512-s+tOYYcC3Jybgr9mVsdAxsRYlGNq4mlAurOrfNuGMQ/SCofNPu92tjE7YRZCsdEtWL1yGkqk15fU/ark206YTg=="
-            crossorigin="anonymous" referrerpolicy="no-referrer"></script>
-    <script type="text/javascript" src="https://cdnjs.cloudflare.com/ajax/libs/highlight.js/10.7.2/languages/go.min.js"
-            integrity="sha512-+UYV2NyyynWEQcZ4sMTKmeppyV331gqvMOGZ61/dqc89Tn1H40lF05ACd03RSD9EWwGutNwKj256mIR8waEJBQ=="
-            crossorigin="anonymous" referrerpolicy="no-referrer"></script>
-    <script type="text/javascript" src="https://cdnjs.cloudflare.com/ajax/libs/react/17.0.2/umd/react.production.min.js"
-            integrity="sha512-qlzIeUtTg7eBpmEaS12NZgxz52YYZVF5myj89mjJEesBd/oE9UPsYOX2QAXzvOAZYEvQohKdcY8zKE02ifXDmA=="
-            crossorigin="anonymous" referrerpolicy="no-referrer"></script>
-    <script type="text/javascript"
-            src="https://cdnjs.cloudflare.com/ajax/libs/react-dom/17.0.2/umd/react-dom.production.min.js"
-            integrity="sha512-9jGNr5Piwe8nzLLYTk8QrEMPfjGU0px80GYzKZUxi7lmCfrBjtyCc1V5kkS5vxVwwIB7Qpzc7UxLiQxfAN30dw=="
-            crossorigin="anonymous" referrerpolicy="no-referrer"></script>
-    <script type="text/javascript" src="https://cdnjs.cloudflare.com/ajax/libs/babel-standalone/6.26.0/babel.min.js"
-            integrity="sha512-kp7YHLxuJDJcOzStgd6vtpxr4ZU9kjn77e6dBsivSz+pUuAuMlE2UTdKB7jjsWT84qbS8kdCWHPETnP/ctrFsA=="
-            crossorigin="anonymous" referrerpolicy="no-referrer"></script>
+    <style>
+        body { margin: 0; padding: 1.5rem; color: #24292f; background: #f6f8fa; }
+        body { font: 14px/1.5 -apple-system, BlinkMacSystemFont, "Segoe UI", Roboto, sans-serif; }
+        main { max-width: 72rem; margin: 0 auto; }
+        h1 { font-size: 1.5rem; margin: 0 0 1rem; }
+        h2 { font-size: 1.1rem; margin: 0; padding: .5rem .75rem; background: #eaeef2; border-radius: 6px 6px 0 0; }
+        h3 { font-size: .95rem; margin: 0; padding: .5rem .75rem; border-top: 1px solid #d0d7de; font-family: monospace; }
+        table { border-collapse: collapse; margin-bottom: 1rem; background: #fff; }
+        th, td { padding: .25rem .75rem; border: 1px solid #d0d7de; text-align: left; }
+        td.count { text-align: right; }
+        .filters { display: flex; gap: .75rem; flex-wrap: wrap; align-items: center; margin-bottom: 1rem; }
+        .filters input, .filters select { font: inherit; padding: .25rem .5rem; }
+        .package { background: #fff; border: 1px solid #d0d7de; border-radius: 6px; margin-bottom: 1rem; }
+        .issue { border-top: 1px solid #eaeef2; }
+        .issue summary { cursor: pointer; padding: .4rem .75rem; list-style-position: inside; }
+        .issue summary:hover { background: #f6f8fa; }
+        .position { font-family: monospace; color: #57606a; }
+        .linter, .severity { display: inline-block; padding: 0 .4rem; border-radius: 1em; font-size: .8rem; }
+        .linter { background: #ddf4ff; color: #0550ae; }
+        .severity { background: #fff1e5; color: #953800; }
+        .source { margin: 0; padding: .5rem .75rem; overflow-x: auto; background: #f6f8fa; font: 13px/1.45 monospace; }
+        .source span { display: block; white-space: pre; }
+        .source span::before { content: attr(data-line); display: inline-block; width: 4em; margin-right: 1em; }
+        .source span::before { color: #8c959f; text-align: right; }
+        .empty { padding: 1rem; background: #fff; border: 1px solid #d0d7de; border-radius: 6px; }
+    </style>
 </head>
 <body>
-<section class="section">
-    <div class="container">
-        <div id="content"></div>
+<main>
+    <h1>golangci-lint: {{ .Total }} issues</h1>
+{{- if .Issues }}
+    <table>
+        <thead><tr><th>Linter</th><th>Issues</th></tr></thead>
+        <tbody>
+{{- range .Linters }}
+            <tr><td><a href="#" data-filter-linter="{{ .Name }}">{{ .Name }}</a></td><td class="count">{{ .Count }}</td></tr>
+{{- end }}
+        </tbody>
+    </table>
+    <div class="filters">
+        <label>Linter <select id="filter-linter"><option value="">All</option>
+{{- range .Linters }}<option>{{ .Name }}</option>{{ end -}}
+        </select></label>
+        <label>Severity <select id="filter-severity"><option value="">All</option>
+{{- range .Severities }}<option>{{ . }}</option>{{ end -}}
+        </select></label>
+        <label>Path <input id="filter-path" type="search" placeholder="path/to/pkg"></label>
+        <span><span id="visible-count">{{ .Total }}</span> issues shown</span>
     </div>
-</section>
-<script>
-    const data = {{ . }};
-</script>
-<script type="text/babel">
-  class Highlight extends React.Component {
-    componentDidMount() {
-      hljs.highlightElement(ReactDOM.findDOMNode(this));
-    }
-
-    render() {
-      return <pre className="go"><code>{this.props.code}</code></pre>;
-    }
-  }
-
-  class Issue extends React.Component {
-    render() {
-      return (
-        <div className="issue box">
-          <div>
-            <div className="columns">
-              <div className="column is-four-fifths">
-                <h5 className="title is-5 has-text-danger-dark">{this.props.data.Title}</h5>
-              </div>
-              <div className="column is-one-fifth">
-                <h6 className="title is-6">{this.props.data.Linter}</h6>
-              </div>
-            </div>
-            <strong>{this.props.data.Pos}</strong>
-          </div>
-          <div className="highlight">
-            <Highlight code={this.props.data.Code}/>
-          </div>
-        </div>
-      );
-    }
-  }
-
-  class Issues extends React.Component {
-    render() {
-      if (!this.props.data.Issues || this.props.data.Issues.length === 0) {
-        return (
-          <div>
-            <div className="notification">
-              No issues found!
-            </div>
-          </div>
-        );
-      }
-
-      return (
-        <div className="issues">
-          {this.props.data.Issues.map(issue => (<Issue data={issue}/>))}
-        </div>
-      );
-    }
-  }
-
-  ReactDOM.render(
-    <div className="content">
-      <div className="columns is-centered">
-        <div className="column is-three-quarters">
-          <Issues data={data}/>
+{{- range .Packages }}
+    <section class="package">
+        <h2>{{ .Path }}</h2>
+{{- range .Files }}
+        <div class="file">
+            <h3>{{ .Path }}</h3>
+{{- range .Issues }}
+            <details class="issue" data-linter="{{ .Linter }}" data-severity="{{ .Severity }}" data-path="{{ .Path }}">
+                <summary><span class="position">{{ .Pos }}</span> {{ .Text }} <span class="linter">{{ .Linter }}</span>
+{{- if .Severity }} <span class="severity">{{ .Severity }}</span>{{ end }}</summary>
+{{- if .Source }}
+                <pre class="source">{{ range .Source }}<span data-line="{{ .Number }}">{{ .Text }}</span>{{ end }}</pre>
+{{- end }}
+            </details>
+{{- end }}
         </div>
-      </div>
-    </div>,
-    document.getElementById("content")
-  );
+{{- end }}
+    </section>
+{{- end }}
+{{- else }}
+    <div class="empty">No issues found!</div>
+{{- end }}
+</main>
+<script>
+    (function () {
+        var linter = document.getElementById("filter-linter");
+        if (!linter) {
+            return;
+        }
+
+        var severity = document.getElementById("filter-severity");
+        var path = document.getElementById("filter-path");
+        var count = document.getElementById("visible-count");
+
+        function apply() {
+            var query = path.value.trim().toLowerCase();
+            var visible = 0;
+
+            document.querySelectorAll(".issue").forEach(function (issue) {
+                var shown = (linter.value === "" || issue.dataset.linter === linter.value) &&
+                    (severity.value === "" || issue.dataset.severity === severity.value) &&
+                    (query === "" || issue.dataset.path.toLowerCase().indexOf(query) !== -1);
+
+                issue.hidden = !shown;
+                if (shown) {
+                    visible++;
+                }
+            });
+
+            document.querySelectorAll(".file, .package").forEach(function (group) {
+                group.hidden = group.querySelector(".issue:not([hidden])") === null;
+            });
+
+            count.textContent = visible;
+        }
+
+        linter.addEventListener("change", apply);
+        severity.addEventListener("change", apply);
+        path.addEventListener("input", apply);
+
+        document.querySelectorAll("[data-filter-linter]").forEach(function (link) {
+            link.addEventListener("click", function (event) {
+                event.preventDefault();
+                linter.value = link.dataset.filterLinter;
+                apply();
+            });
+        });
+    })();
 </script>
 </body>
-</html>`
+</html>
+`
+
+type htmlReport struct {
+	Total      int
+	Issues     bool
+	Linters    []htmlLinter
+	Severities []string
+	Packages   []*htmlPackage
+}
+
+type htmlLinter struct {
+	Name  string
+	Count int
+}
+
+// htmlPackage contains the issues of a directory.
+type htmlPackage struct {
+	Path  string
+	Files []*htmlFile
+}
+
+type htmlFile struct {
+	Path   string
+	Issues []htmlIssue
+}
 
 type htmlIssue struct {
-	Title  string
-	Pos    string
-	Linter string
-	Code   string
+	Text     string
+	Pos      string
+	Path     string
+	Linter   string
+	Severity string
+	Source   []htmlSourceLine
+
+	line, column int
+}
+
+type htmlSourceLine struct {
+	Number int
+	Text   string
 }
 
 type HTML struct {
@@ -131,26 +191,110 @@ func NewHTML(w io.Writer) *HTML {
 }
 
 func (p HTML) Print(issues []result.Issue) error {
-	var htmlIssues []htmlIssue
+	t, err := template.New("golangci-lint").Parse(templateContent)
+	if err != nil {
+		return err
+	}
+
+	return t.Execute(p.w, buildHTMLReport(issues))
+}
+
+// buildHTMLReport groups the issues by package (directory) and file, sorted by path and position.
+func buildHTMLReport(issues []result.Issue) *htmlReport {
+	report := &htmlReport{Total: len(issues), Issues: len(issues) > 0}
+
+	linters := map[string]int{}
+	severities := map[string]bool{}
+	packages := map[string]*htmlPackage{}
+	files := map[string]*htmlFile{}
 
 	for i := range issues {
-		pos := fmt.Sprintf("%s:%d", issues[i].FilePath(), issues[i].Line())
-		if issues[i].Pos.Column != 0 {
-			pos += fmt.Sprintf(":%d", issues[i].Pos.Column)
+		issue := &issues[i]
+
+		path := issue.FilePath()
+
+		file, ok := files[path]
+		if !ok {
+			dir := filepath.Dir(path)
+
+			pkg, found := packages[dir]
+			if !found {
+				pkg = &htmlPackage{Path: dir}
+				packages[dir] = pkg
+			}
+
+			file = &htmlFile{Path: path}
+			files[path] = file
+
+			pkg.Files = append(pkg.Files, file)
+		}
+
+		file.Issues = append(file.Issues, newHTMLIssue(issue))
+
+		linters[issue.FromLinter]++
+
+		if issue.Severity != "" {
+			severities[issue.Severity] = true
 		}
+	}
+
+	for name, count := range linters {
+		report.Linters = append(report.Linters, htmlLinter{Name: name, Count: count})
+	}
+
+	slices.SortFunc(report.Linters, func(a, b htmlLinter) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), strings.Compare(a.Name, b.Name))
+	})
+
+	for severity := range severities {
+		report.Severities = append(report.Severities, severity)
+	}
 
-		htmlIssues = append(htmlIssues, htmlIssue{
-			Title:  strings.TrimSpace(issues[i].Text),
-			Pos:    pos,
-			Linter: issues[i].FromLinter,
-			Code:   strings.Join(issues[i].SourceLines, "\n"),
+	slices.Sort(report.Severities)
+
+	for _, pkg := range packages {
+		slices.SortFunc(pkg.Files, func(a, b *htmlFile) int {
+			return strings.Compare(a.Path, b.Path)
 		})
+
+		for _, file := range pkg.Files {
+			slices.SortStableFunc(file.Issues, func(a, b htmlIssue) int {
+				return cmp.Or(cmp.Compare(a.line, b.line), cmp.Compare(a.column, b.column))
+			})
+		}
+
+		report.Packages = append(report.Packages, pkg)
 	}
 
-	t, err := template.New("golangci-lint").Parse(templateContent)
-	if err != nil {
-		return err
+	slices.SortFunc(report.Packages, func(a, b *htmlPackage) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return report
+}
+
+func newHTMLIssue(issue *result.Issue) htmlIssue {
+	pos := fmt.Sprintf("%s:%d", issue.FilePath(), issue.Line())
+	if issue.Pos.Column != 0 {
+		pos += fmt.Sprintf(":%d", issue.Pos.Column)
+	}
+
+	hi := htmlIssue{
+		Text:     strings.TrimSpace(issue.Text),
+		Pos:      pos,
+		Path:     issue.FilePath(),
+		Linter:   issue.FromLinter,
+		Severity: issue.Severity,
+		line:     issue.Line(),
+		column:   issue.Column(),
+	}
+
+	// The source lines are the lines of the range of the issue.
+	first := issue.GetLineRange().From
+
+	for i, line := range issue.SourceLines {
+		hi.Source = append(hi.Source, htmlSourceLine{Number: first + i, Text: line})
 	}
 
-	return t.Execute(p.w, struct{ Issues []htmlIssue }{Issues: htmlIssues})
+	return hi
 }
@@ -0,0 +1,124 @@
+package printers
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/golangci/golangci-lint/pkg/report"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// Types of the events of the JSONStream printer.
+const (
+	JSONStreamEventIssue    = "issue"
+	JSONStreamEventProgress = "progress"
+	JSONStreamEventWarning  = "warning"
+	JSONStreamEventSummary  = "summary"
+)
+
+// JSONStream prints newline-delimited JSON events:
+// the issues as soon as the linters finish, the progress of the run, the warnings, and a final summary.
+//
+// The streamed issues are processed by the processors able to process each issue (exclusions, nolint, etc.).
+// The summary contains the issues after all the processors (limits, uniqueness, order, etc.): it replaces the streamed issues.
+type JSONStream struct {
+	rd *report.Data
+	w  io.Writer
+
+	mu sync.Mutex
+
+	// streamed is true if the issues are printed as soon as the linters finish.
+	streamed bool
+
+	// err is the first error of the writer.
+	err error
+}
+
+func NewJSONStream(rd *report.Data, w io.Writer) *JSONStream {
+	return &JSONStream{
+		rd: rd,
+		w:  w,
+	}
+}
+
+type JSONStreamEvent struct {
+	Type string
+
+	Issue    *result.Issue       `json:",omitempty"`
+	Progress *JSONStreamProgress `json:",omitempty"`
+	Warning  *report.Warning     `json:",omitempty"`
+	Summary  *JSONResult         `json:",omitempty"`
+}
+
+type JSONStreamProgress struct {
+	Linter   string
+	Finished int
+	Total    int
+}
+
+// StreamIssues prints the issues of a linter.
+func (p *JSONStream) StreamIssues(issues []result.Issue) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.streamed = true
+
+	for i := range issues {
+		p.write(&JSONStreamEvent{Type: JSONStreamEventIssue, Issue: &issues[i]})
+	}
+}
+
+// LinterFinished prints the progress of the run.
+func (p *JSONStream) LinterFinished(name string, finished, total int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.streamed = true
+
+	p.write(&JSONStreamEvent{
+		Type:     JSONStreamEventProgress,
+		Progress: &JSONStreamProgress{Linter: name, Finished: finished, Total: total},
+	})
+}
+
+// Warn prints a warning of the run.
+func (p *JSONStream) Warn(warning report.Warning) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.write(&JSONStreamEvent{Type: JSONStreamEventWarning, Warning: &warning})
+}
+
+// Print prints the summary of the run.
+// The issues are printed before the summary if they were not streamed (e.g. the fixes were applied).
+func (p *JSONStream) Print(issues []result.Issue) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.streamed {
+		for i := range issues {
+			p.write(&JSONStreamEvent{Type: JSONStreamEventIssue, Issue: &issues[i]})
+		}
+	}
+
+	summary := &JSONResult{
+		Issues: issues,
+		Report: p.rd,
+	}
+	if summary.Issues == nil {
+		summary.Issues = []result.Issue{}
+	}
+
+	p.write(&JSONStreamEvent{Type: JSONStreamEventSummary, Summary: summary})
+
+	return p.err
+}
+
+func (p *JSONStream) write(event *JSONStreamEvent) {
+	if p.err != nil {
+		return
+	}
+
+	p.err = json.NewEncoder(p.w).Encode(event)
+}
@@ -0,0 +1,76 @@
+package printers
+
+import (
+	"bytes"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/report"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestJSONStream_stream(t *testing.T) {
+	issues := []result.Issue{
+		{
+			FromLinter: "linter-a",
+			Text:       "some issue",
+			Pos:        token.Position{Filename: "path/to/filea.go", Line: 10, Column: 4},
+		},
+		{
+			FromLinter: "linter-b",
+			Text:       "another issue",
+			Pos:        token.Position{Filename: "path/to/fileb.go", Line: 300, Column: 9},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+
+	printer := NewJSONStream(&report.Data{}, buf)
+
+	printer.StreamIssues(issues[:1])
+	printer.LinterFinished("linter-a", 1, 2)
+	printer.Warn(report.Warning{Tag: "runner", Text: "some warning"})
+	printer.StreamIssues(issues[1:])
+	printer.LinterFinished("linter-b", 2, 2)
+
+	// The summary contains the issues after all the processors.
+	err := printer.Print(issues[1:])
+	require.NoError(t, err)
+
+	expected := `{"Type":"issue","Issue":{"FromLinter":"linter-a","Text":"some issue","Severity":"","SourceLines":null,"Replacement":null,"Pos":{"Filename":"path/to/filea.go","Offset":0,"Line":10,"Column":4},"ExpectNoLint":false,"ExpectedNoLintLinter":""}}
+{"Type":"progress","Progress":{"Linter":"linter-a","Finished":1,"Total":2}}
+{"Type":"warning","Warning":{"Tag":"runner","Text":"some warning"}}
+{"Type":"issue","Issue":{"FromLinter":"linter-b","Text":"another issue","Severity":"","SourceLines":null,"Replacement":null,"Pos":{"Filename":"path/to/fileb.go","Offset":0,"Line":300,"Column":9},"ExpectNoLint":false,"ExpectedNoLintLinter":""}}
+{"Type":"progress","Progress":{"Linter":"linter-b","Finished":2,"Total":2}}
+{"Type":"summary","Summary":{"Issues":[{"FromLinter":"linter-b","Text":"another issue","Severity":"","SourceLines":null,"Replacement":null,"Pos":{"Filename":"path/to/fileb.go","Offset":0,"Line":300,"Column":9},"ExpectNoLint":false,"ExpectedNoLintLinter":""}],"Report":{}}}
+`
+
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestJSONStream_Print(t *testing.T) {
+	issues := []result.Issue{
+		{
+			FromLinter: "linter-a",
+			Text:       "some issue",
+			Pos:        token.Position{Filename: "path/to/filea.go", Line: 10, Column: 4},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+
+	printer := NewJSONStream(nil, buf)
+
+	// The issues were not streamed.
+	err := printer.Print(issues)
+	require.NoError(t, err)
+
+	expected := `{"Type":"issue","Issue":{"FromLinter":"linter-a","Text":"some issue","Severity":"","SourceLines":null,"Replacement":null,"Pos":{"Filename":"path/to/filea.go","Offset":0,"Line":10,"Column":4},"ExpectNoLint":false,"ExpectedNoLintLinter":""}}
+{"Type":"summary","Summary":{"Issues":[{"FromLinter":"linter-a","Text":"some issue","Severity":"","SourceLines":null,"Replacement":null,"Pos":{"Filename":"path/to/filea.go","Offset":0,"Line":10,"Column":4},"ExpectNoLint":false,"ExpectedNoLintLinter":""}],"Report":null}}
+`
+
+	assert.Equal(t, expected, buf.String())
+}
@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 
 	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
 	"github.com/golangci/golangci-lint/pkg/logutils"
 	"github.com/golangci/golangci-lint/pkg/report"
 	"github.com/golangci/golangci-lint/pkg/result"
@@ -23,15 +24,29 @@ type issuePrinter interface {
 type Printer struct {
 	cfg        *config.Output
 	reportData *report.Data
+	linters    []*linter.Config
 
 	log logutils.Log
 
 	stdOut io.Writer
 	stdErr io.Writer
+
+	// streams are the outputs of the streamed formats (see StartStream).
+	streams []*streamOutput
+}
+
+// streamOutput is the output of a streamed format, open from the start of the analysis to the end of the printing.
+type streamOutput struct {
+	index   int // The index of the format.
+	printer *JSONStream
+
+	w           io.Writer
+	shouldClose bool
 }
 
 // NewPrinter creates a new Printer.
-func NewPrinter(log logutils.Log, cfg *config.Output, reportData *report.Data) (*Printer, error) {
+// The linters are used to describe the rules (SARIF).
+func NewPrinter(log logutils.Log, cfg *config.Output, reportData *report.Data, linters []*linter.Config) (*Printer, error) {
 	if log == nil {
 		return nil, errors.New("missing log argument in constructor")
 	}
@@ -45,6 +60,7 @@ func NewPrinter(log logutils.Log, cfg *config.Output, reportData *report.Data) (
 	return &Printer{
 		cfg:        cfg,
 		reportData: reportData,
+		linters:    linters,
 		log:        log,
 		stdOut:     logutils.StdOut,
 		stdErr:     logutils.StdErr,
@@ -53,8 +69,8 @@ func NewPrinter(log logutils.Log, cfg *config.Output, reportData *report.Data) (
 
 // Print prints issues based on the formats defined
 func (c *Printer) Print(issues []result.Issue) error {
-	for _, format := range c.cfg.Formats {
-		err := c.printReports(issues, format)
+	for i, format := range c.cfg.Formats {
+		err := c.printReports(issues, i, format)
 		if err != nil {
 			return err
 		}
@@ -63,7 +79,62 @@ func (c *Printer) Print(issues []result.Issue) error {
 	return nil
 }
 
-func (c *Printer) printReports(issues []result.Issue, format config.OutputFormat) error {
+// StartStream opens the outputs of the streamed formats (json-stream):
+// the issues, the progress, and the warnings, are printed during the analysis (see lint.Stream).
+// It returns false if no format is streamed.
+func (c *Printer) StartStream() (bool, error) {
+	for i, format := range c.cfg.Formats {
+		if format.Format != config.OutFormatJSONStream {
+			continue
+		}
+
+		w, shouldClose, err := c.createWriter(format.Path)
+		if err != nil {
+			return false, fmt.Errorf("can't create output for %s: %w", format.Path, err)
+		}
+
+		c.streams = append(c.streams, &streamOutput{
+			index:       i,
+			printer:     NewJSONStream(c.reportData, w),
+			w:           w,
+			shouldClose: shouldClose,
+		})
+	}
+
+	if len(c.streams) == 0 {
+		return false, nil
+	}
+
+	c.reportData.SetWarningListener(func(warning report.Warning) {
+		for _, s := range c.streams {
+			s.printer.Warn(warning)
+		}
+	})
+
+	return true, nil
+}
+
+// StreamIssues prints the issues of a linter in the streamed formats.
+func (c *Printer) StreamIssues(issues []result.Issue) {
+	for _, s := range c.streams {
+		s.printer.StreamIssues(issues)
+	}
+}
+
+// LinterFinished prints the progress of the analysis in the streamed formats.
+func (c *Printer) LinterFinished(name string, finished, total int) {
+	for _, s := range c.streams {
+		s.printer.LinterFinished(name, finished, total)
+	}
+}
+
+func (c *Printer) printReports(issues []result.Issue, index int, format config.OutputFormat) error {
+	for _, s := range c.streams {
+		if s.index == index {
+			return s.print(issues)
+		}
+	}
+
 	w, shouldClose, err := c.createWriter(format.Path)
 	if err != nil {
 		return fmt.Errorf("can't create output for %s: %w", format.Path, err)
@@ -87,6 +158,20 @@ func (c *Printer) printReports(issues []result.Issue, format config.OutputFormat
 	return nil
 }
 
+func (s *streamOutput) print(issues []result.Issue) error {
+	defer func() {
+		if file, ok := s.w.(io.Closer); s.shouldClose && ok {
+			_ = file.Close()
+		}
+	}()
+
+	if err := s.printer.Print(issues); err != nil {
+		return fmt.Errorf("can't print %d issues: %w", len(issues), err)
+	}
+
+	return nil
+}
+
 func (c *Printer) createWriter(path string) (io.Writer, bool, error) {
 	if path == "" || path == "stdout" {
 		return c.stdOut, false, nil
@@ -115,6 +200,8 @@ func (c *Printer) createPrinter(format string, w io.Writer) (issuePrinter, error
 	switch format {
 	case config.OutFormatJSON:
 		p = NewJSON(c.reportData, w)
+	case config.OutFormatJSONStream:
+		p = NewJSONStream(c.reportData, w)
 	case config.OutFormatLineNumber, config.OutFormatColoredLineNumber:
 		p = NewText(c.cfg.PrintIssuedLine,
 			format == config.OutFormatColoredLineNumber, c.cfg.PrintLinterName,
@@ -136,7 +223,7 @@ func (c *Printer) createPrinter(format string, w io.Writer) (issuePrinter, error
 	case config.OutFormatTeamCity:
 		p = NewTeamCity(w)
 	case config.OutFormatSarif:
-		p = NewSarif(w)
+		p = NewSarif(c.reportData, c.linters, w)
 	default:
 		return nil, fmt.Errorf("unknown output format %q", format)
 	}
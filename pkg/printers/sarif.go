@@ -3,13 +3,24 @@ package printers
 import (
 	"encoding/json"
 	"io"
+	"path/filepath"
+	"strings"
 
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/report"
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
 const (
 	sarifVersion   = "2.1.0"
 	sarifSchemaURI = "https://schemastore.azurewebsites.net/schemas/json/sarif-2.1.0-rtm.6.json"
+
+	// https://docs.oasis-open.org/sarif/sarif/v2.1.0/errata01/os/sarif-v2.1.0-errata01-os-complete.html#_Toc141790925
+	sarifFingerprintKey = "golangciLintFingerprint/v1"
+
+	sarifToolName = "golangci-lint"
+	sarifToolURI  = "https://golangci-lint.run"
 )
 
 type SarifOutput struct {
@@ -19,21 +30,63 @@ type SarifOutput struct {
 }
 
 type sarifRun struct {
-	Tool    sarifTool     `json:"tool"`
-	Results []sarifResult `json:"results"`
+	Tool        sarifTool         `json:"tool"`
+	Invocations []sarifInvocation `json:"invocations,omitempty"`
+	Artifacts   []sarifArtifact   `json:"artifacts,omitempty"`
+	Results     []sarifResult     `json:"results"`
 }
 
 type sarifTool struct {
-	Driver struct {
-		Name string `json:"name"`
-	} `json:"driver"`
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri,omitempty"`
+	Rules          []sarifRule `json:"rules,omitempty"`
+}
+
+type sarifRule struct {
+	ID               string               `json:"id"`
+	Name             string               `json:"name,omitempty"`
+	ShortDescription *sarifMessage        `json:"shortDescription,omitempty"`
+	HelpURI          string               `json:"helpUri,omitempty"`
+	Properties       *sarifRuleProperties `json:"properties,omitempty"`
+}
+
+type sarifRuleProperties struct {
+	Tags []string `json:"tags,omitempty"`
+}
+
+type sarifInvocation struct {
+	ExecutionSuccessful        bool                `json:"executionSuccessful"`
+	ToolExecutionNotifications []sarifNotification `json:"toolExecutionNotifications,omitempty"`
+}
+
+type sarifNotification struct {
+	Level   string       `json:"level"`
+	Message sarifMessage `json:"message"`
+}
+
+type sarifArtifact struct {
+	Location sarifArtifactLocation `json:"location"`
 }
 
 type sarifResult struct {
 	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
 	Level     string          `json:"level"`
 	Message   sarifMessage    `json:"message"`
 	Locations []sarifLocation `json:"locations"`
+	Fixes     []sarifFix      `json:"fixes,omitempty"`
+
+	PartialFingerprints map[string]string `json:"partialFingerprints,omitempty"`
+
+	Properties *sarifResultProperties `json:"properties,omitempty"`
+}
+
+type sarifResultProperties struct {
+	AlsoReportedBy []string `json:"alsoReportedBy,omitempty"`
 }
 
 type sarifMessage struct {
@@ -54,57 +107,73 @@ type sarifArtifactLocation struct {
 	Index int    `json:"index"`
 }
 
+// sarifRegion is expressed either with lines and columns, or with bytes.
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/errata01/os/sarif-v2.1.0-errata01-os-complete.html#_Toc141790936
 type sarifRegion struct {
-	StartLine   int `json:"startLine"`
-	StartColumn int `json:"startColumn"`
+	StartLine   int  `json:"startLine,omitempty"`
+	StartColumn int  `json:"startColumn,omitempty"`
+	EndLine     int  `json:"endLine,omitempty"`
+	EndColumn   int  `json:"endColumn,omitempty"`
+	ByteOffset  *int `json:"byteOffset,omitempty"`
+	ByteLength  *int `json:"byteLength,omitempty"`
+}
+
+type sarifFix struct {
+	Description     *sarifMessage         `json:"description,omitempty"`
+	ArtifactChanges []sarifArtifactChange `json:"artifactChanges"`
+}
+
+type sarifArtifactChange struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Replacements     []sarifReplacement    `json:"replacements"`
+}
+
+type sarifReplacement struct {
+	DeletedRegion   sarifRegion   `json:"deletedRegion"`
+	InsertedContent *sarifMessage `json:"insertedContent,omitempty"`
 }
 
 type Sarif struct {
-	w io.Writer
+	rd      *report.Data
+	linters map[string]*linter.Config
+	w       io.Writer
 }
 
-func NewSarif(w io.Writer) *Sarif {
-	return &Sarif{w: w}
+func NewSarif(rd *report.Data, linters []*linter.Config, w io.Writer) *Sarif {
+	p := &Sarif{
+		rd:      rd,
+		linters: map[string]*linter.Config{},
+		w:       w,
+	}
+
+	for _, lc := range linters {
+		p.linters[lc.Name()] = lc
+	}
+
+	return p
 }
 
 func (p Sarif) Print(issues []result.Issue) error {
+	b := &sarifBuilder{
+		linters:         p.linters,
+		ruleIndexes:     map[string]int{},
+		artifactIndexes: map[string]int{},
+	}
+
 	run := sarifRun{}
-	run.Tool.Driver.Name = "golangci-lint"
+	run.Tool.Driver.Name = sarifToolName
+	run.Tool.Driver.InformationURI = sarifToolURI
 	run.Results = make([]sarifResult, 0)
 
 	for i := range issues {
-		issue := issues[i]
-
-		severity := issue.Severity
+		run.Results = append(run.Results, b.buildResult(&issues[i]))
+	}
 
-		switch severity {
-		// https://docs.oasis-open.org/sarif/sarif/v2.1.0/errata01/os/sarif-v2.1.0-errata01-os-complete.html#_Toc141790898
-		case "none", "note", "warning", "error":
-			// Valid levels.
-		default:
-			severity = "error"
-		}
+	run.Tool.Driver.Rules = b.rules
+	run.Artifacts = b.artifacts
 
-		sr := sarifResult{
-			RuleID:  issue.FromLinter,
-			Level:   severity,
-			Message: sarifMessage{Text: issue.Text},
-			Locations: []sarifLocation{
-				{
-					PhysicalLocation: sarifPhysicalLocation{
-						ArtifactLocation: sarifArtifactLocation{URI: issue.FilePath()},
-						Region: sarifRegion{
-							StartLine: issue.Line(),
-							// If startColumn is absent, it SHALL default to 1.
-							// https://docs.oasis-open.org/sarif/sarif/v2.1.0/errata01/os/sarif-v2.1.0-errata01-os-complete.html#_Toc141790941
-							StartColumn: max(1, issue.Column()),
-						},
-					},
-				},
-			},
-		}
-
-		run.Results = append(run.Results, sr)
+	if p.rd != nil {
+		run.Invocations = []sarifInvocation{buildSarifInvocation(p.rd)}
 	}
 
 	output := SarifOutput{
@@ -115,3 +184,274 @@ func (p Sarif) Print(issues []result.Issue) error {
 
 	return json.NewEncoder(p.w).Encode(output)
 }
+
+// sarifBuilder collects the rules and the artifacts referenced by the results.
+type sarifBuilder struct {
+	linters map[string]*linter.Config
+
+	rules     []sarifRule
+	artifacts []sarifArtifact
+
+	// rule ID -> index inside the rules.
+	ruleIndexes map[string]int
+	// URI -> index inside the artifacts.
+	artifactIndexes map[string]int
+}
+
+func (b *sarifBuilder) buildResult(issue *result.Issue) sarifResult {
+	ruleID, ruleIndex := b.rule(issue)
+
+	sr := sarifResult{
+		RuleID:    ruleID,
+		RuleIndex: ruleIndex,
+		Level:     toSarifLevel(issue.Severity),
+		Message:   sarifMessage{Text: issue.Text},
+		Locations: []sarifLocation{
+			{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: b.artifactLocation(issue.FilePath()),
+					Region:           buildSarifRegion(issue),
+				},
+			},
+		},
+		Fixes: b.buildFixes(issue),
+	}
+
+	if issue.FingerprintID != "" {
+		sr.PartialFingerprints = map[string]string{sarifFingerprintKey: issue.FingerprintID}
+	}
+
+	if len(issue.AlsoReportedBy) > 0 {
+		sr.Properties = &sarifResultProperties{AlsoReportedBy: issue.AlsoReportedBy}
+	}
+
+	return sr
+}
+
+// rule returns the ID and the index of the rule of an issue.
+// The rule is the linter, or the rule of the linter (ex: `govet/printf`).
+func (b *sarifBuilder) rule(issue *result.Issue) (id string, index int) {
+	lc := b.linters[issue.FromLinter]
+
+	id = issue.Rule()
+
+	index, ok := b.ruleIndexes[id]
+	if ok {
+		return id, index
+	}
+
+	rule := sarifRule{ID: id, Name: issue.FromLinter}
+
+	if issue.RuleID != "" {
+		rule.Name = issue.RuleID
+	}
+
+	if lc != nil {
+		rule.ShortDescription = &sarifMessage{Text: lc.Linter.Desc()}
+		rule.HelpURI = lc.OriginalURL
+
+		if len(lc.InPresets) > 0 {
+			rule.Properties = &sarifRuleProperties{Tags: lc.InPresets}
+		}
+	}
+
+	index = len(b.rules)
+
+	b.ruleIndexes[id] = index
+	b.rules = append(b.rules, rule)
+
+	return id, index
+}
+
+func (b *sarifBuilder) artifactLocation(filename string) sarifArtifactLocation {
+	uri := filepath.ToSlash(filename)
+
+	index, ok := b.artifactIndexes[uri]
+	if !ok {
+		index = len(b.artifacts)
+		b.artifactIndexes[uri] = index
+
+		b.artifacts = append(b.artifacts, sarifArtifact{Location: sarifArtifactLocation{URI: uri, Index: index}})
+	}
+
+	return sarifArtifactLocation{URI: uri, Index: index}
+}
+
+// buildFixes converts the fixes of an issue: the suggested fixes or the replacement.
+func (b *sarifBuilder) buildFixes(issue *result.Issue) []sarifFix {
+	if len(issue.SuggestedFixes) > 0 {
+		return b.buildSuggestedFixes(issue.SuggestedFixes)
+	}
+
+	if issue.Replacement == nil {
+		return nil
+	}
+
+	replacement := sarifReplacement{}
+
+	lineRange := issue.GetLineRange()
+
+	switch {
+	case issue.Replacement.Inline != nil:
+		inline := issue.Replacement.Inline
+
+		replacement.DeletedRegion = sarifRegion{
+			StartLine:   issue.Line(),
+			StartColumn: inline.StartCol + 1,
+			EndLine:     issue.Line(),
+			EndColumn:   inline.StartCol + inline.Length + 1,
+		}
+		replacement.InsertedContent = &sarifMessage{Text: inline.NewString}
+
+	case issue.Replacement.NeedOnlyDelete:
+		// The lines are deleted with their line endings.
+		replacement.DeletedRegion = sarifRegion{
+			StartLine:   lineRange.From,
+			StartColumn: 1,
+			EndLine:     lineRange.To + 1,
+			EndColumn:   1,
+		}
+
+	default:
+		// A region without columns covers the lines without their line endings.
+		replacement.DeletedRegion = sarifRegion{
+			StartLine: lineRange.From,
+			EndLine:   lineRange.To,
+		}
+		replacement.InsertedContent = &sarifMessage{Text: strings.Join(issue.Replacement.NewLines, "\n")}
+	}
+
+	return []sarifFix{{
+		ArtifactChanges: []sarifArtifactChange{{
+			ArtifactLocation: b.artifactLocation(issue.FilePath()),
+			Replacements:     []sarifReplacement{replacement},
+		}},
+	}}
+}
+
+func (b *sarifBuilder) buildSuggestedFixes(fixes []result.SuggestedFix) []sarifFix {
+	var sfs []sarifFix
+
+	for _, fix := range fixes {
+		sf := sarifFix{}
+
+		if fix.Message != "" {
+			sf.Description = &sarifMessage{Text: fix.Message}
+		}
+
+		// The edits are grouped by file, in the order of their appearance.
+		changes := map[string]int{}
+
+		for _, edit := range fix.TextEdits {
+			location := b.artifactLocation(sarifFixPath(edit.Filename))
+
+			index, ok := changes[location.URI]
+			if !ok {
+				index = len(sf.ArtifactChanges)
+				changes[location.URI] = index
+
+				sf.ArtifactChanges = append(sf.ArtifactChanges, sarifArtifactChange{ArtifactLocation: location})
+			}
+
+			replacement := sarifReplacement{
+				DeletedRegion: sarifRegion{
+					ByteOffset: ptr(edit.Start),
+					ByteLength: ptr(edit.End - edit.Start),
+				},
+			}
+
+			if edit.NewText != "" {
+				replacement.InsertedContent = &sarifMessage{Text: edit.NewText}
+			}
+
+			sf.ArtifactChanges[index].Replacements = append(sf.ArtifactChanges[index].Replacements, replacement)
+		}
+
+		sfs = append(sfs, sf)
+	}
+
+	return sfs
+}
+
+func buildSarifRegion(issue *result.Issue) sarifRegion {
+	region := sarifRegion{
+		StartLine: issue.Line(),
+		// If startColumn is absent, it SHALL default to 1.
+		// https://docs.oasis-open.org/sarif/sarif/v2.1.0/errata01/os/sarif-v2.1.0-errata01-os-complete.html#_Toc141790941
+		StartColumn: max(1, issue.Column()),
+	}
+
+	if lineRange := issue.GetLineRange(); lineRange.To > region.StartLine {
+		region.EndLine = lineRange.To
+	}
+
+	// The end column is exclusive, like the end of the issue.
+	if issue.End != nil && issue.EndLine() >= region.StartLine {
+		region.EndLine = issue.EndLine()
+		region.EndColumn = issue.EndColumn()
+	}
+
+	return region
+}
+
+const incompleteResultsMessage = "Timeout exceeded: the issues of the packages not analyzed before the timeout are missing"
+
+func buildSarifInvocation(rd *report.Data) sarifInvocation {
+	invocation := sarifInvocation{ExecutionSuccessful: rd.Error == "" && !rd.Incomplete}
+
+	for _, warning := range rd.Warnings {
+		text := warning.Text
+		if warning.Tag != "" {
+			text = "[" + warning.Tag + "] " + text
+		}
+
+		invocation.ToolExecutionNotifications = append(invocation.ToolExecutionNotifications,
+			sarifNotification{Level: "warning", Message: sarifMessage{Text: text}})
+	}
+
+	if rd.Incomplete {
+		invocation.ToolExecutionNotifications = append(invocation.ToolExecutionNotifications,
+			sarifNotification{Level: "error", Message: sarifMessage{Text: incompleteResultsMessage}})
+	}
+
+	if rd.Error != "" {
+		invocation.ToolExecutionNotifications = append(invocation.ToolExecutionNotifications,
+			sarifNotification{Level: "error", Message: sarifMessage{Text: rd.Error}})
+	}
+
+	return invocation
+}
+
+// toSarifLevel maps the severity of an issue to a SARIF level.
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/errata01/os/sarif-v2.1.0-errata01-os-complete.html#_Toc141790898
+func toSarifLevel(severity string) string {
+	switch strings.ToLower(severity) {
+	case "none", "note", "warning", "error":
+		return strings.ToLower(severity)
+	case "info", "low", "minor":
+		return "note"
+	case "medium", "major":
+		return "warning"
+	default:
+		return "error"
+	}
+}
+
+// sarifFixPath returns the path of a file edited by a fix, relative to the working directory if possible.
+// The paths of the edits are not prettified like the paths of the issues.
+func sarifFixPath(filename string) string {
+	if !filepath.IsAbs(filename) {
+		return filename
+	}
+
+	rel, err := fsutils.ShortestRelPath(filename, "")
+	if err != nil {
+		return filename
+	}
+
+	return rel
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -2,21 +2,36 @@ package printers
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"go/token"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/report"
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
+type fakeLinter struct {
+	name string
+}
+
+func (fakeLinter) Run(_ context.Context, _ *linter.Context) ([]result.Issue, error) { return nil, nil }
+
+func (l fakeLinter) Name() string { return l.name }
+
+func (l fakeLinter) Desc() string { return "Description of " + l.name }
+
 func TestSarif_Print(t *testing.T) {
 	issues := []result.Issue{
 		{
-			FromLinter: "linter-a",
-			Severity:   "warning",
-			Text:       "some issue",
+			FromLinter:    "linter-a",
+			Severity:      "warning",
+			Text:          "some issue",
+			FingerprintID: "fa",
 			Pos: token.Position{
 				Filename: "path/to/filea.go",
 				Offset:   2,
@@ -33,6 +48,7 @@ func TestSarif_Print(t *testing.T) {
 				"\tfmt.Println(\"bar\")",
 				"}",
 			},
+			AlsoReportedBy: []string{"linter-d"},
 			Pos: token.Position{
 				Filename: "path/to/fileb.go",
 				Offset:   5,
@@ -50,6 +66,11 @@ func TestSarif_Print(t *testing.T) {
 				Line:     11,
 				Column:   5,
 			},
+			End: &result.Position{
+				Offset: 10,
+				Line:   11,
+				Column: 12,
+			},
 		},
 		{
 			FromLinter: "linter-c",
@@ -65,12 +86,12 @@ func TestSarif_Print(t *testing.T) {
 
 	buf := new(bytes.Buffer)
 
-	printer := NewSarif(buf)
+	printer := NewSarif(nil, nil, buf)
 
 	err := printer.Print(issues)
 	require.NoError(t, err)
 
-	expected := `{"version":"2.1.0","$schema":"https://schemastore.azurewebsites.net/schemas/json/sarif-2.1.0-rtm.6.json","runs":[{"tool":{"driver":{"name":"golangci-lint"}},"results":[{"ruleId":"linter-a","level":"warning","message":{"text":"some issue"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/filea.go","index":0},"region":{"startLine":10,"startColumn":4}}}]},{"ruleId":"linter-b","level":"error","message":{"text":"another issue"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/fileb.go","index":0},"region":{"startLine":300,"startColumn":9}}}]},{"ruleId":"linter-a","level":"error","message":{"text":"some issue 2"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/filec.go","index":0},"region":{"startLine":11,"startColumn":5}}}]},{"ruleId":"linter-c","level":"error","message":{"text":"some issue without column"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/filed.go","index":0},"region":{"startLine":11,"startColumn":1}}}]}]}]}
+	expected := `{"version":"2.1.0","$schema":"https://schemastore.azurewebsites.net/schemas/json/sarif-2.1.0-rtm.6.json","runs":[{"tool":{"driver":{"name":"golangci-lint","informationUri":"https://golangci-lint.run","rules":[{"id":"linter-a","name":"linter-a"},{"id":"linter-b","name":"linter-b"},{"id":"linter-c","name":"linter-c"}]}},"artifacts":[{"location":{"uri":"path/to/filea.go","index":0}},{"location":{"uri":"path/to/fileb.go","index":1}},{"location":{"uri":"path/to/filec.go","index":2}},{"location":{"uri":"path/to/filed.go","index":3}}],"results":[{"ruleId":"linter-a","ruleIndex":0,"level":"warning","message":{"text":"some issue"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/filea.go","index":0},"region":{"startLine":10,"startColumn":4}}}],"partialFingerprints":{"golangciLintFingerprint/v1":"fa"}},{"ruleId":"linter-b","ruleIndex":1,"level":"error","message":{"text":"another issue"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/fileb.go","index":1},"region":{"startLine":300,"startColumn":9}}}],"properties":{"alsoReportedBy":["linter-d"]}},{"ruleId":"linter-a","ruleIndex":0,"level":"note","message":{"text":"some issue 2"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/filec.go","index":2},"region":{"startLine":11,"startColumn":5,"endLine":11,"endColumn":12}}}]},{"ruleId":"linter-c","ruleIndex":2,"level":"error","message":{"text":"some issue without column"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/filed.go","index":3},"region":{"startLine":11,"startColumn":1}}}]}]}]}
 `
 
 	assert.Equal(t, expected, buf.String())
@@ -79,13 +100,197 @@ func TestSarif_Print(t *testing.T) {
 func TestSarif_Print_empty(t *testing.T) {
 	buf := new(bytes.Buffer)
 
-	printer := NewSarif(buf)
+	printer := NewSarif(nil, nil, buf)
 
 	err := printer.Print(nil)
 	require.NoError(t, err)
 
-	expected := `{"version":"2.1.0","$schema":"https://schemastore.azurewebsites.net/schemas/json/sarif-2.1.0-rtm.6.json","runs":[{"tool":{"driver":{"name":"golangci-lint"}},"results":[]}]}
+	expected := `{"version":"2.1.0","$schema":"https://schemastore.azurewebsites.net/schemas/json/sarif-2.1.0-rtm.6.json","runs":[{"tool":{"driver":{"name":"golangci-lint","informationUri":"https://golangci-lint.run"}},"results":[]}]}
 `
 
 	assert.Equal(t, expected, buf.String())
 }
+
+func TestSarif_Print_rules(t *testing.T) {
+	linters := []*linter.Config{
+		linter.NewConfig(fakeLinter{name: "govet"}).
+			WithPresets(linter.PresetBugs, linter.PresetMetaLinter).
+			WithURL("https://pkg.go.dev/cmd/vet"),
+		linter.NewConfig(fakeLinter{name: "godot"}).
+			WithPresets(linter.PresetStyle, linter.PresetComment).
+			WithURL("https://github.com/tetafro/godot"),
+	}
+
+	rd := &report.Data{
+		Warnings: []report.Warning{{Tag: "runner", Text: "Can't run linter goanalysis_metalinter"}},
+	}
+
+	issues := []result.Issue{
+		{
+			FromLinter: "govet",
+			Text:       "printf: fmt.Sprintf format %d has arg \"a\" of wrong type string",
+			RuleID:     "printf",
+			Pos:        token.Position{Filename: "path/to/filea.go", Line: 10, Column: 4},
+			LineRange:  &result.Range{From: 10, To: 12},
+			SuggestedFixes: []result.SuggestedFix{{
+				Message:   "Use %s",
+				TextEdits: []result.TextEdit{{Filename: "path/to/filea.go", Start: 120, End: 122, NewText: "%s"}},
+			}},
+		},
+		{
+			FromLinter: "govet",
+			Text:       "shadow: declaration of \"err\" shadows declaration at line 8",
+			RuleID:     "shadow",
+			Pos:        token.Position{Filename: "path/to/fileb.go", Line: 20, Column: 2},
+		},
+		{
+			FromLinter: "godot",
+			Severity:   "low",
+			Text:       "Comment should end in a period",
+			Pos:        token.Position{Filename: "path/to/filea.go", Line: 30, Column: 38},
+			Replacement: &result.Replacement{
+				Inline: &result.InlineFix{StartCol: 37, NewString: "."},
+			},
+		},
+		{
+			FromLinter: "govet",
+			Text:       "printf: fmt.Sprintf format %d reads arg #2, but call has 1 arg",
+			RuleID:     "printf",
+			Pos:        token.Position{Filename: "path/to/fileb.go", Line: 40, Column: 4},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+
+	printer := NewSarif(rd, linters, buf)
+
+	err := printer.Print(issues)
+	require.NoError(t, err)
+
+	var output SarifOutput
+	err = json.Unmarshal(buf.Bytes(), &output)
+	require.NoError(t, err)
+
+	require.Len(t, output.Runs, 1)
+
+	run := output.Runs[0]
+
+	expectedRules := []sarifRule{
+		{
+			ID:               "govet/printf",
+			Name:             "printf",
+			ShortDescription: &sarifMessage{Text: "Description of govet"},
+			HelpURI:          "https://pkg.go.dev/cmd/vet",
+			Properties:       &sarifRuleProperties{Tags: []string{linter.PresetBugs, linter.PresetMetaLinter}},
+		},
+		{
+			ID:               "govet/shadow",
+			Name:             "shadow",
+			ShortDescription: &sarifMessage{Text: "Description of govet"},
+			HelpURI:          "https://pkg.go.dev/cmd/vet",
+			Properties:       &sarifRuleProperties{Tags: []string{linter.PresetBugs, linter.PresetMetaLinter}},
+		},
+		{
+			ID:               "godot",
+			Name:             "godot",
+			ShortDescription: &sarifMessage{Text: "Description of godot"},
+			HelpURI:          "https://github.com/tetafro/godot",
+			Properties:       &sarifRuleProperties{Tags: []string{linter.PresetStyle, linter.PresetComment}},
+		},
+	}
+
+	assert.Equal(t, expectedRules, run.Tool.Driver.Rules)
+
+	expectedArtifacts := []sarifArtifact{
+		{Location: sarifArtifactLocation{URI: "path/to/filea.go", Index: 0}},
+		{Location: sarifArtifactLocation{URI: "path/to/fileb.go", Index: 1}},
+	}
+
+	assert.Equal(t, expectedArtifacts, run.Artifacts)
+
+	expectedInvocations := []sarifInvocation{{
+		ExecutionSuccessful: true,
+		ToolExecutionNotifications: []sarifNotification{
+			{Level: "warning", Message: sarifMessage{Text: "[runner] Can't run linter goanalysis_metalinter"}},
+		},
+	}}
+
+	assert.Equal(t, expectedInvocations, run.Invocations)
+
+	require.Len(t, run.Results, 4)
+
+	assert.Equal(t, []int{0, 1, 2, 0}, []int{
+		run.Results[0].RuleIndex, run.Results[1].RuleIndex, run.Results[2].RuleIndex, run.Results[3].RuleIndex,
+	})
+
+	assert.Equal(t, sarifRegion{StartLine: 10, StartColumn: 4, EndLine: 12},
+		run.Results[0].Locations[0].PhysicalLocation.Region)
+
+	expectedSuggestedFix := []sarifFix{{
+		Description: &sarifMessage{Text: "Use %s"},
+		ArtifactChanges: []sarifArtifactChange{{
+			ArtifactLocation: sarifArtifactLocation{URI: "path/to/filea.go", Index: 0},
+			Replacements: []sarifReplacement{{
+				DeletedRegion:   sarifRegion{ByteOffset: ptr(120), ByteLength: ptr(2)},
+				InsertedContent: &sarifMessage{Text: "%s"},
+			}},
+		}},
+	}}
+
+	assert.Equal(t, expectedSuggestedFix, run.Results[0].Fixes)
+
+	assert.Equal(t, "note", run.Results[2].Level)
+
+	expectedReplacementFix := []sarifFix{{
+		ArtifactChanges: []sarifArtifactChange{{
+			ArtifactLocation: sarifArtifactLocation{URI: "path/to/filea.go", Index: 0},
+			Replacements: []sarifReplacement{{
+				DeletedRegion:   sarifRegion{StartLine: 30, StartColumn: 38, EndLine: 30, EndColumn: 38},
+				InsertedContent: &sarifMessage{Text: "."},
+			}},
+		}},
+	}}
+
+	assert.Equal(t, expectedReplacementFix, run.Results[2].Fixes)
+}
+
+func Test_toSarifLevel(t *testing.T) {
+	testCases := []struct {
+		severity string
+		expected string
+	}{
+		{severity: "", expected: "error"},
+		{severity: "warning", expected: "warning"},
+		{severity: "Note", expected: "note"},
+		{severity: "info", expected: "note"},
+		{severity: "low", expected: "note"},
+		{severity: "medium", expected: "warning"},
+		{severity: "major", expected: "warning"},
+		{severity: "high", expected: "error"},
+		{severity: "blocker", expected: "error"},
+		{severity: "unknown", expected: "error"},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.severity, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, toSarifLevel(test.severity))
+		})
+	}
+}
+
+func Test_buildSarifInvocation_incomplete(t *testing.T) {
+	t.Parallel()
+
+	invocation := buildSarifInvocation(&report.Data{Incomplete: true})
+
+	expected := sarifInvocation{
+		ExecutionSuccessful: false,
+		ToolExecutionNotifications: []sarifNotification{
+			{Level: "error", Message: sarifMessage{Text: incompleteResultsMessage}},
+		},
+	}
+
+	assert.Equal(t, expected, invocation)
+}
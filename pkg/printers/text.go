@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/fatih/color"
 
@@ -90,5 +91,21 @@ func (p *Text) printUnderLinePointer(issue *result.Issue) {
 		}
 	}
 
-	fmt.Fprintf(p.w, "%s%s\n", string(prefixRunes), p.SprintfColored(color.FgYellow, "^"))
+	fmt.Fprintf(p.w, "%s%s\n", string(prefixRunes), p.SprintfColored(color.FgYellow, "%s", underline(issue, line)))
+}
+
+// underline returns the pointer of the issue: the whole span if the issue ends on its line.
+func underline(issue *result.Issue, line string) string {
+	if issue.EndLine() != issue.Line() || issue.EndColumn() <= issue.Column() {
+		return "^"
+	}
+
+	from := issue.Column() - 1
+	to := min(issue.EndColumn()-1, len(line))
+
+	if from >= to {
+		return "^"
+	}
+
+	return strings.Repeat("^", utf8.RuneCountInString(line[from:to]))
 }
@@ -124,3 +124,59 @@ path/to/fileb.go:300:9: another issue
 		})
 	}
 }
+
+func Test_underline(t *testing.T) {
+	line := "\tfmt.Println(\"héllo\")"
+
+	testCases := []struct {
+		desc     string
+		issue    result.Issue
+		expected string
+	}{
+		{
+			desc:     "no end",
+			issue:    result.Issue{Pos: token.Position{Line: 10, Column: 2}},
+			expected: "^",
+		},
+		{
+			desc: "end on the line",
+			issue: result.Issue{
+				Pos: token.Position{Line: 10, Column: 2},
+				End: &result.Position{Line: 10, Column: 13},
+			},
+			expected: "^^^^^^^^^^^",
+		},
+		{
+			desc: "multi-byte runes",
+			issue: result.Issue{
+				Pos: token.Position{Line: 10, Column: 14},
+				End: &result.Position{Line: 10, Column: 22},
+			},
+			expected: "^^^^^^^",
+		},
+		{
+			desc: "end on another line",
+			issue: result.Issue{
+				Pos: token.Position{Line: 10, Column: 2},
+				End: &result.Position{Line: 12, Column: 2},
+			},
+			expected: "^",
+		},
+		{
+			desc: "end after the line",
+			issue: result.Issue{
+				Pos: token.Position{Line: 10, Column: 13},
+				End: &result.Position{Line: 10, Column: 100},
+			},
+			expected: "^^^^^^^^^",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, underline(&test.issue, line))
+		})
+	}
+}
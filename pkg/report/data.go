@@ -15,6 +15,27 @@ type Data struct {
 	Warnings []Warning    `json:",omitempty"`
 	Linters  []LinterData `json:",omitempty"`
 	Error    string       `json:",omitempty"`
+
+	// Incomplete is true if the analysis was interrupted by the timeout:
+	// the issues of the packages not analyzed before the timeout are missing.
+	Incomplete bool `json:",omitempty"`
+
+	// warningListener receives the warnings as soon as they are added (see SetWarningListener).
+	warningListener func(Warning)
+}
+
+// AddWarning adds a warning to the report.
+func (d *Data) AddWarning(w Warning) {
+	d.Warnings = append(d.Warnings, w)
+
+	if d.warningListener != nil {
+		d.warningListener(w)
+	}
+}
+
+// SetWarningListener sets a function receiving the warnings as soon as they are added (e.g. streamed outputs).
+func (d *Data) SetWarningListener(listener func(Warning)) {
+	d.warningListener = listener
 }
 
 func (d *Data) AddLinter(name string, enabled, enabledByDefault bool) {
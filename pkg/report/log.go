@@ -40,7 +40,7 @@ func (lw LogWrapper) Warnf(format string, args ...any) {
 		Text: fmt.Sprintf(format, args...),
 	}
 
-	lw.rd.Warnings = append(lw.rd.Warnings, w)
+	lw.rd.AddWarning(w)
 }
 
 func (lw LogWrapper) Infof(format string, args ...any) {
@@ -24,10 +24,47 @@ type InlineFix struct {
 	NewString string
 }
 
+// SuggestedFix is a serializable version of [analysis.SuggestedFix]:
+// the edits are expressed with byte offsets instead of [token.Pos],
+// so they can be stored in the cache and applied without a [token.FileSet].
+//
+// [analysis.SuggestedFix]: https://pkg.go.dev/golang.org/x/tools/go/analysis#SuggestedFix
+type SuggestedFix struct {
+	Message   string
+	TextEdits []TextEdit
+}
+
+// TextEdit replaces the bytes [Start, End) of the file Filename with NewText.
+// An insertion is represented by Start == End.
+type TextEdit struct {
+	Filename string
+	Start    int // zero-based byte offset
+	End      int // zero-based byte offset, exclusive
+	NewText  string
+}
+
+// Module locates an issue in its module, when several modules are analyzed.
+type Module struct {
+	Path     string // The module path.
+	Dir      string // The directory of the module, relative to the working directory.
+	Filename string // The path of the file, relative to the directory of the module.
+}
+
+// Position is a position in the file of an issue.
+type Position struct {
+	Offset int // zero-based byte offset
+	Line   int // one-based
+	Column int // one-based, in bytes
+}
+
 type Issue struct {
 	FromLinter string
 	Text       string
 
+	// RuleID identifies the check of the linter reporting the issue (ex: `SA4006`, `G104`, `printf`).
+	// It's empty if the linter has only one check.
+	RuleID string `json:",omitempty"`
+
 	Severity string
 
 	// Source lines of a code with the issue to show
@@ -36,6 +73,10 @@ type Issue struct {
 	// If we know how to fix the issue we can provide replacement lines
 	Replacement *Replacement
 
+	// SuggestedFixes are the fixes provided by go/analysis analyzers.
+	// They can span several lines and several files.
+	SuggestedFixes []SuggestedFix `json:",omitempty"`
+
 	// Pkg is needed for proper caching of linting results
 	Pkg *packages.Package `json:"-"`
 
@@ -43,9 +84,25 @@ type Issue struct {
 
 	Pos token.Position
 
+	// End is the position just after the issue, in the file of Pos.
+	// It's nil if the linter doesn't provide it, and its column is 0 if only the end line is known.
+	End *Position `json:",omitempty"`
+
 	// HunkPos is used only when golangci-lint is run over a diff
 	HunkPos int `json:",omitempty"`
 
+	// Module is set only when several modules are analyzed.
+	Module *Module `json:",omitempty"`
+
+	// Fingerprint identifies the issue across the commits:
+	// it doesn't depend on the file path, the line, or the column of the issue.
+	// It's computed by the processors (see processors.Fingerprint).
+	FingerprintID string `json:"Fingerprint,omitempty"`
+
+	// AlsoReportedBy are the other sources (`linter` or `linter/code`) of the issue,
+	// when several linters report the same problem (see processors.Equivalents).
+	AlsoReportedBy []string `json:",omitempty"`
+
 	// If we are expecting a nolint (because this is from nolintlint), record the expected linter
 	ExpectNoLint         bool
 	ExpectedNoLintLinter string
@@ -63,6 +120,24 @@ func (i *Issue) Column() int {
 	return i.Pos.Column
 }
 
+// EndLine returns the line of the end of the issue, or 0 if it's unknown.
+func (i *Issue) EndLine() int {
+	if i.End == nil {
+		return 0
+	}
+
+	return i.End.Line
+}
+
+// EndColumn returns the column just after the issue, or 0 if it's unknown.
+func (i *Issue) EndColumn() int {
+	if i.End == nil {
+		return 0
+	}
+
+	return i.End.Column
+}
+
 func (i *Issue) GetLineRange() Range {
 	if i.LineRange == nil {
 		return Range{
@@ -81,11 +156,26 @@ func (i *Issue) GetLineRange() Range {
 	return *i.LineRange
 }
 
+// Rule returns the linter and the rule of the issue (`linter/rule`), or only the linter if the rule is unknown.
+func (i *Issue) Rule() string {
+	if i.RuleID == "" {
+		return i.FromLinter
+	}
+
+	return i.FromLinter + "/" + i.RuleID
+}
+
 func (i *Issue) Description() string {
 	return fmt.Sprintf("%s: %s", i.FromLinter, i.Text)
 }
 
+// Fingerprint returns the fingerprint computed by the processors (see FingerprintID),
+// or a hash of the file path, the text, and the first source line of the issue if it's not computed.
 func (i *Issue) Fingerprint() string {
+	if i.FingerprintID != "" {
+		return i.FingerprintID
+	}
+
 	firstLine := ""
 	if len(i.SourceLines) > 0 {
 		firstLine = i.SourceLines[0]
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"go/parser"
 	"go/token"
-	"path/filepath"
 	"regexp"
 	"strings"
 
@@ -33,7 +32,7 @@ const (
 	genSwaggerCodegen = "* generated by: swagger codegen "
 )
 
-var _ Processor = (*AutogeneratedExclude)(nil)
+var _ StreamProcessor = (*AutogeneratedExclude)(nil)
 
 type fileSummary struct {
 	generated bool
@@ -61,6 +60,10 @@ func (*AutogeneratedExclude) Name() string {
 	return "autogenerated_exclude"
 }
 
+func (*AutogeneratedExclude) IsStreamable() bool {
+	return true
+}
+
 func (p *AutogeneratedExclude) Process(issues []result.Issue) ([]result.Issue, error) {
 	if p.mode == AutogeneratedModeDisable {
 		return issues, nil
@@ -72,7 +75,8 @@ func (p *AutogeneratedExclude) Process(issues []result.Issue) ([]result.Issue, e
 func (*AutogeneratedExclude) Finish() {}
 
 func (p *AutogeneratedExclude) shouldPassIssue(issue *result.Issue) (bool, error) {
-	if filepath.Base(issue.FilePath()) == "go.mod" {
+	// The files other than Go files (ex: go.mod, or the files of the `command` linters) are not generated Go files.
+	if !isGoFile(issue.FilePath()) {
 		return true, nil
 	}
 
@@ -178,6 +178,17 @@ func Test_shouldPassIssue(t *testing.T) {
 			},
 			assert: assert.True,
 		},
+		{
+			desc: "non Go file",
+			mode: AutogeneratedModeLax,
+			issue: &result.Issue{
+				FromLinter: "example",
+				Pos: token.Position{
+					Filename: filepath.FromSlash("api/service.proto"),
+				},
+			},
+			assert: assert.True,
+		},
 	}
 
 	for _, test := range testCases {
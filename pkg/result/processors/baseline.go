@@ -0,0 +1,391 @@
+package processors
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+const baselineVersion = 1
+
+const baselineFileMode = 0o644
+
+var _ StreamProcessor = (*Baseline)(nil)
+
+type baselineFile struct {
+	Version int             `json:"version"`
+	Issues  []baselineEntry `json:"issues"`
+}
+
+type baselineEntry struct {
+	Fingerprint string `json:"fingerprint"`
+	Linter      string `json:"linter"`
+	File        string `json:"file"`
+	Text        string `json:"text"`
+	Count       int    `json:"count"`
+}
+
+// Baseline filters the issues already known (recorded inside a baseline file),
+// or records all the issues inside a baseline file.
+//
+// The issues are identified by their fingerprints (computed by the Fingerprint processor):
+// the identical issues of several files have the same fingerprint, they are filtered by the count of their entries.
+//
+// Only the entries of the files inside the directories of the analyzed packages are handled:
+// the entries of the other files are never reported as stale, and they are kept when the baseline is written.
+type Baseline struct {
+	log            logutils.Log
+	enabledLinters map[string]*linter.Config
+
+	writePath string
+
+	// directories of the analyzed packages (nil: all the directories).
+	scope map[string]bool
+
+	// remaining number of issues to filter by fingerprint.
+	entries map[string]*baselineEntry
+
+	// number of issues of the entries outside the analyzed packages by fingerprint.
+	outOfScope map[string]int
+
+	// entries of the previous baseline, kept when the baseline is written.
+	previous []baselineEntry
+
+	// the processors aren't called without issues: the baseline is written when the processor finishes.
+	written bool
+}
+
+// NewBaseline creates a Baseline processor.
+// The analyzedDirs are the directories of the analyzed packages: without them, all the entries are in the scope of the run.
+func NewBaseline(log logutils.Log, cfg *config.Issues, enabledLinters map[string]*linter.Config,
+	analyzedDirs []string,
+) (*Baseline, error) {
+	p := &Baseline{
+		log:            log,
+		enabledLinters: enabledLinters,
+		writePath:      cfg.BaselineWrite,
+	}
+
+	if analyzedDirs != nil {
+		p.scope = map[string]bool{}
+
+		for _, dir := range analyzedDirs {
+			p.scope[normalizeBaselineDir(dir)] = true
+		}
+	}
+
+	if cfg.BaselineWrite != "" {
+		p.readPrevious(cfg)
+
+		return p, nil
+	}
+
+	if cfg.Baseline == "" {
+		return p, nil
+	}
+
+	entries, err := readBaseline(cfg.Baseline)
+	if err != nil {
+		return nil, err
+	}
+
+	p.entries = map[string]*baselineEntry{}
+	p.outOfScope = map[string]int{}
+
+	for i := range entries {
+		entry := entries[i]
+
+		if !p.inScope(entry.File) {
+			p.outOfScope[entry.Fingerprint] += entry.Count
+		}
+
+		existing, ok := p.entries[entry.Fingerprint]
+		if !ok {
+			p.entries[entry.Fingerprint] = &entry
+			continue
+		}
+
+		existing.Count += entry.Count
+
+		// The stale issues are reported inside the analyzed packages.
+		if !p.inScope(existing.File) && p.inScope(entry.File) {
+			existing.File = entry.File
+		}
+	}
+
+	return p, nil
+}
+
+func (*Baseline) Name() string {
+	return "baseline"
+}
+
+// IsStreamable returns false when the baseline is written: the file contains all the issues.
+func (p *Baseline) IsStreamable() bool {
+	return p.writePath == ""
+}
+
+func (p *Baseline) Process(issues []result.Issue) ([]result.Issue, error) {
+	if p.writePath != "" {
+		return p.write(issues)
+	}
+
+	if p.entries == nil {
+		return issues, nil
+	}
+
+	return filterIssues(issues, p.shouldPassIssue), nil
+}
+
+func (p *Baseline) Finish() {
+	if p.writePath != "" && !p.written {
+		if _, err := p.write(nil); err != nil {
+			p.log.Warnf("Can't write the baseline: %v", err)
+		}
+	}
+
+	stale := p.staleEntries()
+	if len(stale) == 0 {
+		return
+	}
+
+	for _, entry := range stale {
+		p.log.Infof("Stale baseline entry: %s: %s (%s) x%d", entry.File, entry.Text, entry.Linter, entry.Count)
+	}
+
+	p.log.Warnf("%d baseline entries don't match any issue anymore: "+
+		"the baseline can be pruned with the option 'baseline-write'", len(stale))
+}
+
+// staleEntries returns the entries that don't match any issue of the run.
+func (p *Baseline) staleEntries() []baselineEntry {
+	var stale []baselineEntry
+	for fingerprint, entry := range p.entries {
+		// The issues of the files outside the analyzed packages can't be found.
+		count := entry.Count - p.outOfScope[fingerprint]
+
+		// The issues of the disabled linters can't be found.
+		if count <= 0 || p.enabledLinters[entry.Linter] == nil {
+			continue
+		}
+
+		staleEntry := *entry
+		staleEntry.Count = count
+
+		stale = append(stale, staleEntry)
+	}
+
+	sort.Slice(stale, func(i, j int) bool {
+		if stale[i].File != stale[j].File {
+			return stale[i].File < stale[j].File
+		}
+		return stale[i].Text < stale[j].Text
+	})
+
+	return stale
+}
+
+func (p *Baseline) inScope(file string) bool {
+	return p.scope == nil || p.scope[normalizeBaselineDir(filepath.Dir(filepath.FromSlash(file)))]
+}
+
+// readPrevious reads the previous baseline to keep its entries outside the analyzed packages.
+// The entries inside the analyzed packages are replaced: this allows to prune them.
+func (p *Baseline) readPrevious(cfg *config.Issues) {
+	if p.scope == nil {
+		return
+	}
+
+	previousPath := cfg.Baseline
+	if previousPath == "" {
+		previousPath = cfg.BaselineWrite
+	}
+
+	if _, err := os.Stat(previousPath); errors.Is(err, os.ErrNotExist) {
+		return
+	}
+
+	previous, err := readBaseline(previousPath)
+	if err != nil {
+		// An unreadable baseline (ex: an old version) is regenerated.
+		p.log.Warnf("The previous baseline is ignored: %v", err)
+		return
+	}
+
+	p.previous = previous
+}
+
+func (p *Baseline) shouldPassIssue(issue *result.Issue) bool {
+	entry, ok := p.entries[issue.FingerprintID]
+	if !ok || entry.Count <= 0 {
+		return true
+	}
+
+	entry.Count--
+
+	return false
+}
+
+func (p *Baseline) write(issues []result.Issue) ([]result.Issue, error) {
+	// fingerprint and file -> entry.
+	entries := map[[2]string]*baselineEntry{}
+
+	var count int
+	for i := range issues {
+		issue := &issues[i]
+		if issue.FromLinter == typeCheckName {
+			continue
+		}
+
+		file := filepath.ToSlash(issue.FilePath())
+
+		key := [2]string{issue.FingerprintID, file}
+
+		entry, ok := entries[key]
+		if !ok {
+			entry = &baselineEntry{
+				Fingerprint: issue.FingerprintID,
+				Linter:      issue.FromLinter,
+				File:        file,
+				Text:        issue.Text,
+			}
+
+			entries[key] = entry
+		}
+
+		entry.Count++
+		count++
+	}
+
+	// The files with issues are analyzed, even outside the directories of the packages (ex: the files of external tools).
+	analyzedFiles := map[string]bool{}
+	for _, entry := range entries {
+		analyzedFiles[entry.File] = true
+	}
+
+	var kept int
+	for i := range p.previous {
+		entry := p.previous[i]
+
+		if p.inScope(entry.File) || analyzedFiles[entry.File] {
+			continue
+		}
+
+		key := [2]string{entry.Fingerprint, entry.File}
+
+		if existing, ok := entries[key]; ok {
+			existing.Count += entry.Count
+		} else {
+			entries[key] = &entry
+		}
+
+		kept += entry.Count
+	}
+
+	if kept > 0 {
+		p.log.Infof("%d issues of the previous baseline outside the analyzed packages are kept", kept)
+	}
+
+	err := writeBaseline(p.writePath, entries)
+	if err != nil {
+		return nil, err
+	}
+
+	p.written = true
+
+	p.log.Infof("Baseline with %d issues written to %s", count+kept, p.writePath)
+
+	// All the issues are now known, except the compilation errors:
+	// they are not recorded, and they are still reported.
+	return filterIssuesUnsafe(issues, func(issue *result.Issue) bool {
+		return issue.FromLinter == typeCheckName
+	}), nil
+}
+
+// normalizeBaselineDir returns the directory in the form of the paths of the baseline entries (relative to the working directory).
+func normalizeBaselineDir(dir string) string {
+	if filepath.IsAbs(dir) {
+		if rel, err := fsutils.ShortestRelPath(dir, ""); err == nil {
+			dir = rel
+		}
+	}
+
+	return filepath.ToSlash(filepath.Clean(dir))
+}
+
+func readBaseline(path string) ([]baselineEntry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("can't read baseline: %w", err)
+	}
+
+	var baseline baselineFile
+	err = json.Unmarshal(data, &baseline)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse baseline %s: %w", path, err)
+	}
+
+	if baseline.Version != baselineVersion {
+		return nil, fmt.Errorf("unsupported baseline version %d in %s: the baseline must be regenerated", baseline.Version, path)
+	}
+
+	for i := range baseline.Issues {
+		if baseline.Issues[i].Fingerprint == "" {
+			return nil, errors.New("invalid baseline: an entry without fingerprint")
+		}
+	}
+
+	return baseline.Issues, nil
+}
+
+func writeBaseline(path string, entries map[[2]string]*baselineEntry) error {
+	baseline := baselineFile{
+		Version: baselineVersion,
+		Issues:  make([]baselineEntry, 0, len(entries)),
+	}
+
+	for _, entry := range entries {
+		baseline.Issues = append(baseline.Issues, *entry)
+	}
+
+	// Stable output: it's expected to be committed.
+	sort.Slice(baseline.Issues, func(i, j int) bool {
+		a, b := baseline.Issues[i], baseline.Issues[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Linter != b.Linter {
+			return a.Linter < b.Linter
+		}
+		if a.Text != b.Text {
+			return a.Text < b.Text
+		}
+		return a.Fingerprint < b.Fingerprint
+	})
+
+	data, err := json.MarshalIndent(baseline, "", "  ")
+	if err != nil {
+		return fmt.Errorf("can't marshal baseline: %w", err)
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err != nil {
+		return fmt.Errorf("can't create baseline directory: %w", err)
+	}
+
+	err = os.WriteFile(path, append(data, '\n'), baselineFileMode)
+	if err != nil {
+		return fmt.Errorf("can't write baseline: %w", err)
+	}
+
+	return nil
+}
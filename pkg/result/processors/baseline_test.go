@@ -0,0 +1,208 @@
+package processors
+
+import (
+	"fmt"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func newBaselineIssue(fromLinter, file string, line int, text string) result.Issue {
+	return result.Issue{
+		FromLinter: fromLinter,
+		Text:       text,
+		Pos:        token.Position{Filename: file, Line: line},
+	}
+}
+
+func TestBaseline_writeAndRead(t *testing.T) {
+	dir := t.TempDir()
+
+	file := filepath.Join(dir, "a.go")
+	baselinePath := filepath.Join(dir, "baseline.json")
+
+	err := os.WriteFile(file, []byte("package a\n\nfunc a() {\n\tfoo()\n\tfoo()\n\tbar()\n}\n"), 0o600)
+	require.NoError(t, err)
+
+	log := logutils.NewStderrLog(logutils.DebugKeyEmpty)
+	enabledLinters := map[string]*linter.Config{"errcheck": {}, "unused": {}}
+
+	issues := []result.Issue{
+		newBaselineIssue("errcheck", file, 4, "Error return value is not checked"),
+		newBaselineIssue("errcheck", file, 5, "Error return value is not checked"),
+		newBaselineIssue("unused", file, 3, "func `a` is unused"),
+		newBaselineIssue(typeCheckName, file, 6, "undefined: bar"),
+	}
+
+	issues, err = NewFingerprint(log, fsutils.NewLineCache(fsutils.NewFileCache())).Process(issues)
+	require.NoError(t, err)
+
+	// write
+
+	pw, err := NewBaseline(log, &config.Issues{BaselineWrite: baselinePath}, enabledLinters, nil)
+	require.NoError(t, err)
+
+	got, err := pw.Process(issues)
+	require.NoError(t, err)
+
+	assert.Equal(t, []result.Issue{issues[3]}, got)
+
+	entries, err := readBaseline(baselinePath)
+	require.NoError(t, err)
+
+	require.Len(t, entries, 3)
+
+	// Lines moved: 2 lines are inserted before the issues.
+	err = os.WriteFile(file, []byte("package a\n\n// a is a function.\n//\nfunc a() {\n\tfoo()\n\tfoo()\n\tbar()\n\tfoo()\n}\n"), 0o600)
+	require.NoError(t, err)
+
+	// read
+
+	pr, err := NewBaseline(log, &config.Issues{Baseline: baselinePath}, enabledLinters, nil)
+	require.NoError(t, err)
+
+	newIssues := []result.Issue{
+		newBaselineIssue("errcheck", file, 6, "Error return value is not checked"),
+		newBaselineIssue("errcheck", file, 7, "Error return value is not checked"),
+		newBaselineIssue("errcheck", file, 9, "Error return value is not checked"), // new
+		newBaselineIssue(typeCheckName, file, 8, "undefined: bar"),
+	}
+
+	newIssues, err = NewFingerprint(log, fsutils.NewLineCache(fsutils.NewFileCache())).Process(newIssues)
+	require.NoError(t, err)
+
+	got, err = pr.Process(newIssues)
+	require.NoError(t, err)
+
+	assert.Equal(t, []result.Issue{newIssues[2], newIssues[3]}, got)
+
+	// The unused issue is stale.
+	var stale []string
+	for _, entry := range pr.entries {
+		if entry.Count > 0 {
+			stale = append(stale, entry.Linter)
+		}
+	}
+
+	assert.Equal(t, []string{"unused"}, stale)
+}
+
+func TestBaseline_scope(t *testing.T) {
+	dir := t.TempDir()
+
+	fileA := filepath.Join(dir, "a", "a.go")
+	fileB := filepath.Join(dir, "b", "b.go")
+
+	for _, file := range []string{fileA, fileB} {
+		require.NoError(t, os.MkdirAll(filepath.Dir(file), 0o750))
+
+		err := os.WriteFile(file, []byte("package a\n\nfunc a() {\n\tfoo()\n}\n"), 0o600)
+		require.NoError(t, err)
+	}
+
+	baselinePath := filepath.Join(dir, "baseline.json")
+
+	log := logutils.NewStderrLog(logutils.DebugKeyEmpty)
+	enabledLinters := map[string]*linter.Config{"errcheck": {}}
+
+	issues := []result.Issue{
+		newBaselineIssue("errcheck", fileA, 4, "Error return value is not checked"),
+		newBaselineIssue("errcheck", fileB, 4, "Error return value is not checked"),
+	}
+
+	issues, err := NewFingerprint(log, fsutils.NewLineCache(fsutils.NewFileCache())).Process(issues)
+	require.NoError(t, err)
+
+	pw, err := NewBaseline(log, &config.Issues{BaselineWrite: baselinePath}, enabledLinters, nil)
+	require.NoError(t, err)
+
+	_, err = pw.Process(issues)
+	require.NoError(t, err)
+
+	entries, err := readBaseline(baselinePath)
+	require.NoError(t, err)
+
+	// The identical issues of the 2 files have the same fingerprint.
+	require.Len(t, entries, 2)
+
+	// Only the package of the directory "a" is analyzed, and its issue is fixed.
+
+	pr, err := NewBaseline(log, &config.Issues{Baseline: baselinePath}, enabledLinters, []string{filepath.Dir(fileA)})
+	require.NoError(t, err)
+
+	_, err = pr.Process(nil)
+	require.NoError(t, err)
+
+	stale := pr.staleEntries()
+	require.Len(t, stale, 1)
+
+	assert.Equal(t, filepath.ToSlash(fileA), stale[0].File)
+	assert.Equal(t, 1, stale[0].Count)
+
+	// The baseline is written again without issues: the entry outside the analyzed packages is kept.
+
+	pw, err = NewBaseline(log, &config.Issues{BaselineWrite: baselinePath}, enabledLinters, []string{filepath.Dir(fileA)})
+	require.NoError(t, err)
+
+	// Without issues, the processors aren't called: the baseline is written when the processor finishes.
+	pw.Finish()
+
+	entries, err = readBaseline(baselinePath)
+	require.NoError(t, err)
+
+	expected := []baselineEntry{{
+		Fingerprint: issues[1].FingerprintID,
+		Linter:      "errcheck",
+		File:        filepath.ToSlash(fileB),
+		Text:        "Error return value is not checked",
+		Count:       1,
+	}}
+
+	assert.Equal(t, expected, entries)
+}
+
+func TestNewBaseline_error(t *testing.T) {
+	dir := t.TempDir()
+
+	testCases := []struct {
+		desc    string
+		content string
+	}{
+		{
+			desc:    "invalid JSON",
+			content: "{",
+		},
+		{
+			desc:    "unsupported version",
+			content: `{"version": 42, "issues": []}`,
+		},
+		{
+			desc:    "missing fingerprint",
+			content: `{"version": 1, "issues": [{"linter": "errcheck", "count": 1}]}`,
+		},
+	}
+
+	for i, test := range testCases {
+		baselinePath := filepath.Join(dir, fmt.Sprintf("baseline%d.json", i))
+
+		err := os.WriteFile(baselinePath, []byte(test.content), 0o600)
+		require.NoError(t, err)
+
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewBaseline(logutils.NewStderrLog(logutils.DebugKeyEmpty), &config.Issues{Baseline: baselinePath}, nil, nil)
+			require.Error(t, err)
+		})
+	}
+}
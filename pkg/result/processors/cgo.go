@@ -9,7 +9,7 @@ import (
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
-var _ Processor = (*Cgo)(nil)
+var _ StreamProcessor = (*Cgo)(nil)
 
 type Cgo struct {
 	goCacheDir string
@@ -25,6 +25,10 @@ func (Cgo) Name() string {
 	return "cgo"
 }
 
+func (Cgo) IsStreamable() bool {
+	return true
+}
+
 func (p Cgo) Process(issues []result.Issue) ([]result.Issue, error) {
 	return filterIssuesErr(issues, p.shouldPassIssue)
 }
@@ -2,6 +2,7 @@ package processors
 
 import (
 	"bytes"
+	"cmp"
 	"fmt"
 	"io"
 	"os"
@@ -15,7 +16,7 @@ import (
 
 const envGolangciDiffProcessorPatch = "GOLANGCI_DIFF_PROCESSOR_PATCH"
 
-var _ Processor = (*Diff)(nil)
+var _ StreamProcessor = (*Diff)(nil)
 
 type Diff struct {
 	onlyNew       bool
@@ -25,12 +26,15 @@ type Diff struct {
 	patch         string
 }
 
-func NewDiff(cfg *config.Issues) *Diff {
+func NewDiff(cfg *config.Config) *Diff {
+	// Only the packages changed since the revision are analyzed: the issues are filtered the same way.
+	fromRev := cmp.Or(cfg.Issues.DiffFromRevision, cfg.Run.ChangedSince)
+
 	return &Diff{
-		onlyNew:       cfg.Diff,
-		fromRev:       cfg.DiffFromRevision,
-		patchFilePath: cfg.DiffPatchFilePath,
-		wholeFiles:    cfg.WholeFiles,
+		onlyNew:       cfg.Issues.Diff,
+		fromRev:       fromRev,
+		patchFilePath: cfg.Issues.DiffPatchFilePath,
+		wholeFiles:    cfg.Issues.WholeFiles,
 		patch:         os.Getenv(envGolangciDiffProcessorPatch),
 	}
 }
@@ -39,6 +43,10 @@ func (Diff) Name() string {
 	return "diff"
 }
 
+func (Diff) IsStreamable() bool {
+	return true
+}
+
 func (p Diff) Process(issues []result.Issue) ([]result.Issue, error) {
 	if !p.onlyNew && p.fromRev == "" && p.patchFilePath == "" && p.patch == "" { // no need to work
 		return issues, nil
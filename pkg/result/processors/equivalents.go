@@ -0,0 +1,191 @@
+package processors
+
+import (
+	"slices"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+var _ Processor = (*Equivalents)(nil)
+
+type equivalentSource struct {
+	name   string
+	linter string
+	rule   string
+}
+
+// Equivalents merges the issues reported on the same line by several linters for the same problem.
+// The issue of the first source of a group is kept, the other sources are listed in [result.Issue.AlsoReportedBy].
+type Equivalents struct {
+	log    logutils.Log
+	groups [][]equivalentSource
+}
+
+func NewEquivalents(log logutils.Log, cfg *config.Issues) *Equivalents {
+	// The groups of the configuration are before the default groups: their order of the sources wins.
+	equivalents := slices.Clone(cfg.Equivalents)
+	if cfg.UseDefaultEquivalents {
+		equivalents = append(equivalents, config.DefaultEquivalents...)
+	}
+
+	p := &Equivalents{log: log}
+
+	for _, sources := range mergeEquivalents(equivalents) {
+		var group []equivalentSource
+
+		for _, name := range sources {
+			linter, rule := config.SplitEquivalentSource(name)
+
+			group = append(group, equivalentSource{name: name, linter: linter, rule: rule})
+		}
+
+		p.groups = append(p.groups, group)
+	}
+
+	return p
+}
+
+func (*Equivalents) Name() string {
+	return "equivalents"
+}
+
+func (p *Equivalents) Process(issues []result.Issue) ([]result.Issue, error) {
+	if len(p.groups) == 0 {
+		return issues, nil
+	}
+
+	type lineKey struct {
+		filename string
+		line     int
+		group    int
+	}
+
+	type match struct {
+		key  lineKey
+		rank int
+		name string
+	}
+
+	matches := make([]*match, len(issues))
+
+	// The best rank of the sources of a group reporting an issue on a line.
+	best := map[lineKey]int{}
+
+	for i := range issues {
+		group, rank := p.find(&issues[i])
+		if group < 0 {
+			continue
+		}
+
+		key := lineKey{filename: issues[i].FilePath(), line: issues[i].Line(), group: group}
+
+		matches[i] = &match{key: key, rank: rank, name: issues[i].Rule()}
+
+		if r, ok := best[key]; !ok || rank < r {
+			best[key] = rank
+		}
+	}
+
+	// The sources of the issues merged into the canonical issues.
+	others := map[lineKey][]string{}
+
+	for _, m := range matches {
+		if m == nil || m.rank == best[m.key] || slices.Contains(others[m.key], m.name) {
+			continue
+		}
+
+		others[m.key] = append(others[m.key], m.name)
+	}
+
+	var out []result.Issue
+
+	for i := range issues {
+		m := matches[i]
+
+		switch {
+		case m == nil:
+			out = append(out, issues[i])
+
+		case m.rank == best[m.key]:
+			issue := issues[i]
+			issue.AlsoReportedBy = append(slices.Clone(issue.AlsoReportedBy), others[m.key]...)
+
+			out = append(out, issue)
+
+		default:
+			p.log.Infof("The issue %q of %s is merged into the issue of %s at %s:%d",
+				issues[i].Text, m.name, p.groups[m.key.group][best[m.key]].name, m.key.filename, m.key.line)
+		}
+	}
+
+	return out, nil
+}
+
+func (*Equivalents) Finish() {}
+
+// mergeEquivalents merges the groups sharing a source:
+// the sources are in the order of their first appearance.
+func mergeEquivalents(equivalents [][]string) [][]string {
+	var merged [][]string
+
+	for _, sources := range equivalents {
+		var (
+			kept  [][]string
+			group []string
+		)
+
+		index := -1
+
+		for _, m := range merged {
+			if !slices.ContainsFunc(m, func(source string) bool { return slices.Contains(sources, source) }) {
+				kept = append(kept, m)
+				continue
+			}
+
+			if index < 0 {
+				index = len(kept)
+			}
+
+			group = appendMissing(group, m)
+		}
+
+		group = appendMissing(group, sources)
+
+		if index < 0 {
+			index = len(kept)
+		}
+
+		merged = slices.Insert(kept, index, group)
+	}
+
+	return merged
+}
+
+func appendMissing(dst, sources []string) []string {
+	for _, source := range sources {
+		if !slices.Contains(dst, source) {
+			dst = append(dst, source)
+		}
+	}
+
+	return dst
+}
+
+// find returns the index of the first group of the issue, and the index of its source inside the group.
+func (p *Equivalents) find(issue *result.Issue) (group, rank int) {
+	for i, sources := range p.groups {
+		for j, source := range sources {
+			if source.linter != issue.FromLinter {
+				continue
+			}
+
+			if source.rule == "" || source.rule == issue.RuleID {
+				return i, j
+			}
+		}
+	}
+
+	return -1, -1
+}
@@ -0,0 +1,116 @@
+package processors
+
+import (
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func newEquivalentIssue(linter, ruleID, text string, line int) result.Issue {
+	return result.Issue{
+		FromLinter: linter,
+		RuleID:     ruleID,
+		Text:       text,
+		Pos:        token.Position{Filename: "file.go", Line: line},
+	}
+}
+
+func TestEquivalents_Process(t *testing.T) {
+	cfg := &config.Issues{
+		UseDefaultEquivalents: true,
+		Equivalents:           [][]string{{"errcheck", "errchkjson"}},
+	}
+
+	p := NewEquivalents(logutils.NewStderrLog(logutils.DebugKeyEmpty), cfg)
+
+	issues := []result.Issue{
+		newEquivalentIssue("gosec", "G104", "G104: Errors unhandled.", 10),
+		newEquivalentIssue("errcheck", "", "Error return value of `f` is not checked", 10),
+		newEquivalentIssue("errchkjson", "", "Error return value of `encoding/json.Marshal` is not checked", 10),
+		newEquivalentIssue("revive", "unhandled-error", "unhandled-error: Unhandled error in call to function f", 10),
+		// Another check of gosec.
+		newEquivalentIssue("gosec", "G304", "G304: Potential file inclusion via variable", 10),
+		// Another line.
+		newEquivalentIssue("gosec", "G104", "G104: Errors unhandled.", 20),
+		newEquivalentIssue("staticcheck", "SA4006", "SA4006: this value of `err` is never used", 30),
+		newEquivalentIssue("ineffassign", "", "ineffectual assignment to err", 30),
+	}
+
+	processed, err := p.Process(issues)
+	require.NoError(t, err)
+
+	errcheckIssue := issues[1]
+	errcheckIssue.AlsoReportedBy = []string{"gosec/G104", "errchkjson", "revive/unhandled-error"}
+
+	ineffassignIssue := issues[7]
+	ineffassignIssue.AlsoReportedBy = []string{"staticcheck/SA4006"}
+
+	expected := []result.Issue{
+		errcheckIssue,
+		issues[4],
+		issues[5],
+		ineffassignIssue,
+	}
+
+	assert.Equal(t, expected, processed)
+}
+
+func TestEquivalents_Process_sameLinter(t *testing.T) {
+	p := NewEquivalents(logutils.NewStderrLog(logutils.DebugKeyEmpty), &config.Issues{UseDefaultEquivalents: true})
+
+	// The issues of the canonical source are all kept.
+	issues := []result.Issue{
+		newEquivalentIssue("errcheck", "", "Error return value of `f` is not checked", 10),
+		newEquivalentIssue("errcheck", "", "Error return value of `g` is not checked", 10),
+		newEquivalentIssue("gosec", "G104", "G104: Errors unhandled.", 10),
+		newEquivalentIssue("gosec", "G104", "G104: Errors unhandled.", 10),
+	}
+
+	processed, err := p.Process(issues)
+	require.NoError(t, err)
+
+	require.Len(t, processed, 2)
+
+	for _, issue := range processed {
+		assert.Equal(t, "errcheck", issue.FromLinter)
+		assert.Equal(t, []string{"gosec/G104"}, issue.AlsoReportedBy)
+	}
+}
+
+func TestEquivalents_Process_noDefault(t *testing.T) {
+	p := NewEquivalents(logutils.NewStderrLog(logutils.DebugKeyEmpty), &config.Issues{})
+
+	issues := []result.Issue{
+		newEquivalentIssue("errcheck", "", "Error return value of `f` is not checked", 10),
+		newEquivalentIssue("gosec", "G104", "G104: Errors unhandled.", 10),
+	}
+
+	processed, err := p.Process(issues)
+	require.NoError(t, err)
+
+	assert.Equal(t, issues, processed)
+}
+
+func Test_mergeEquivalents(t *testing.T) {
+	equivalents := [][]string{
+		{"gosec/G104", "errcheck"},
+		{"ineffassign", "staticcheck/SA4006"},
+		{"errcheck", "gosec/G104", "revive/unhandled-error"},
+		{"errchkjson", "errcheck"},
+		{"stylecheck/ST1005", "revive/error-strings"},
+	}
+
+	expected := [][]string{
+		{"gosec/G104", "errcheck", "revive/unhandled-error", "errchkjson"},
+		{"ineffassign", "staticcheck/SA4006"},
+		{"stylecheck/ST1005", "revive/error-strings"},
+	}
+
+	assert.Equal(t, expected, mergeEquivalents(equivalents))
+}
@@ -9,7 +9,7 @@ import (
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
-var _ Processor = (*Exclude)(nil)
+var _ StreamProcessor = (*Exclude)(nil)
 
 type Exclude struct {
 	name string
@@ -42,6 +42,10 @@ func (p Exclude) Name() string {
 	return p.name
 }
 
+func (Exclude) IsStreamable() bool {
+	return true
+}
+
 func (p Exclude) Process(issues []result.Issue) ([]result.Issue, error) {
 	if p.pattern == nil {
 		return issues, nil
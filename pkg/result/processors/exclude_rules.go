@@ -9,7 +9,7 @@ import (
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
-var _ Processor = (*ExcludeRules)(nil)
+var _ StreamProcessor = (*ExcludeRules)(nil)
 
 type excludeRule struct {
 	baseRule
@@ -57,6 +57,10 @@ func NewExcludeRules(log logutils.Log, files *fsutils.Files, cfg *config.Issues)
 
 func (p ExcludeRules) Name() string { return p.name }
 
+func (ExcludeRules) IsStreamable() bool {
+	return true
+}
+
 func (p ExcludeRules) Process(issues []result.Issue) ([]result.Issue, error) {
 	if len(p.rules) == 0 {
 		return issues, nil
@@ -81,6 +85,7 @@ func createRules(rules []config.ExcludeRule, prefix string) []excludeRule {
 	for _, rule := range rules {
 		parsedRule := excludeRule{}
 		parsedRule.linters = rule.Linters
+		parsedRule.rules = rule.Rules
 
 		if rule.Text != "" {
 			parsedRule.text = regexp.MustCompile(prefix + rule.Text)
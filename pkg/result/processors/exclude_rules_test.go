@@ -170,6 +170,33 @@ func TestExcludeRules_text(t *testing.T) {
 	assert.Equal(t, texts[1:], processedTexts)
 }
 
+func TestExcludeRules_rules(t *testing.T) {
+	opts := &config.Issues{
+		ExcludeRules: []config.ExcludeRule{
+			{
+				BaseRule: config.BaseRule{
+					Rules:   []string{"SA4006", "SA4011"},
+					Linters: []string{"staticcheck"},
+				},
+			},
+		},
+	}
+
+	p := NewExcludeRules(nil, nil, opts)
+
+	issues := []result.Issue{
+		{FromLinter: "staticcheck", RuleID: "SA4006"},
+		{FromLinter: "staticcheck", RuleID: "SA4011"},
+		{FromLinter: "staticcheck", RuleID: "SA1019"},
+		{FromLinter: "staticcheck"},
+		{FromLinter: "gosec", RuleID: "SA4006"},
+	}
+
+	processedIssues := process(t, p, issues...)
+
+	assert.Equal(t, issues[2:], processedIssues)
+}
+
 func TestExcludeRules_empty(t *testing.T) {
 	processAssertSame(t, NewExcludeRules(nil, nil, &config.Issues{}), newIssueFromTextTestCase("test"))
 }
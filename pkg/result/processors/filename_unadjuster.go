@@ -14,7 +14,7 @@ import (
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
-var _ Processor = (*FilenameUnadjuster)(nil)
+var _ StreamProcessor = (*FilenameUnadjuster)(nil)
 
 type posMapper func(pos token.Position) token.Position
 
@@ -59,6 +59,10 @@ func (*FilenameUnadjuster) Name() string {
 	return "filename_unadjuster"
 }
 
+func (*FilenameUnadjuster) IsStreamable() bool {
+	return true
+}
+
 func (p *FilenameUnadjuster) Process(issues []result.Issue) ([]result.Issue, error) {
 	return transformIssues(issues, func(issue *result.Issue) *result.Issue {
 		issueFilePath := issue.FilePath()
@@ -78,6 +82,14 @@ func (p *FilenameUnadjuster) Process(issues []result.Issue) ([]result.Issue, err
 
 		newIssue := *issue
 		newIssue.Pos = mapper(issue.Pos)
+
+		// The mapper uses the offsets: an end without offset (only the line is known) cannot be unadjusted.
+		if issue.End != nil && issue.End.Offset > 0 {
+			end := mapper(token.Position{Filename: issue.Pos.Filename, Offset: issue.End.Offset})
+			newIssue.End = &result.Position{Offset: end.Offset, Line: end.Line, Column: end.Column}
+		} else {
+			newIssue.End = nil
+		}
 		if !p.loggedUnadjustments[issue.Pos.Filename] {
 			p.log.Infof("Unadjusted from %v to %v", issue.Pos, newIssue.Pos)
 			p.loggedUnadjustments[issue.Pos.Filename] = true
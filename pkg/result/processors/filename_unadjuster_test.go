@@ -0,0 +1,62 @@
+package processors
+
+import (
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestFilenameUnadjuster_Process(t *testing.T) {
+	dir := t.TempDir()
+
+	// Like the files generated by cgo, the positions of the file are adjusted by a line directive.
+	content := "//line adjusted.go:10\npackage sample\n\nvar value = 1\n"
+
+	filename := filepath.Join(dir, "sample.go")
+
+	err := os.WriteFile(filename, []byte(content), 0o600)
+	require.NoError(t, err)
+
+	p := NewFilenameUnadjuster([]*packages.Package{{CompiledGoFiles: []string{filename}}}, logutils.NewStderrLog(logutils.DebugKeyEmpty))
+
+	offset := strings.Index(content, "value")
+
+	issues := []result.Issue{
+		{
+			FromLinter: "linter",
+			Pos:        token.Position{Filename: filepath.Join(dir, "adjusted.go"), Offset: offset, Line: 12, Column: 5},
+			End:        &result.Position{Offset: offset + len("value"), Line: 12, Column: 10},
+		},
+		{
+			FromLinter: "linter",
+			Pos:        token.Position{Filename: filepath.Join(dir, "adjusted.go"), Offset: offset, Line: 12, Column: 5},
+			End:        &result.Position{Line: 13},
+		},
+	}
+
+	processed, err := p.Process(issues)
+	require.NoError(t, err)
+
+	expected := []result.Issue{
+		{
+			FromLinter: "linter",
+			Pos:        token.Position{Filename: filename, Offset: offset, Line: 4, Column: 5},
+			End:        &result.Position{Offset: offset + len("value"), Line: 4, Column: 10},
+		},
+		{
+			FromLinter: "linter",
+			Pos:        token.Position{Filename: filename, Offset: offset, Line: 4, Column: 5},
+		},
+	}
+
+	assert.Equal(t, expected, processed)
+}
@@ -0,0 +1,208 @@
+package processors
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+var _ StreamProcessor = (*Fingerprint)(nil)
+
+var fingerprintDigitsRe = regexp.MustCompile(`\d+`)
+
+type declRange struct {
+	name     string
+	from, to int
+}
+
+// Fingerprint computes the fingerprints of the issues.
+//
+// A fingerprint identifies an issue across the commits:
+// it doesn't depend on the file path, the line, or the column of the issue.
+// It's computed from the linter name, the name of the enclosing declaration,
+// the normalized text of the issue, the source line without whitespaces,
+// and an occurrence index that distinguishes the identical issues of a file.
+//
+// The identical issues of different files have the same fingerprint: the baseline counts them.
+type Fingerprint struct {
+	log       logutils.Log
+	lineCache *fsutils.LineCache
+
+	// file path -> top-level declarations.
+	decls map[string][]declRange
+}
+
+func NewFingerprint(log logutils.Log, lineCache *fsutils.LineCache) *Fingerprint {
+	return &Fingerprint{
+		log:       log,
+		lineCache: lineCache,
+		decls:     map[string][]declRange{},
+	}
+}
+
+func (*Fingerprint) Name() string {
+	return "fingerprint"
+}
+
+func (*Fingerprint) IsStreamable() bool {
+	return true
+}
+
+func (p *Fingerprint) Process(issues []result.Issue) ([]result.Issue, error) {
+	keys := make([]string, len(issues))
+	order := make([]int, len(issues))
+
+	for i := range issues {
+		keys[i] = p.key(&issues[i])
+		order[i] = i
+	}
+
+	// The occurrence indexes only depend on the positions of the issues inside their file:
+	// an issue added in a file doesn't change the fingerprints of the other files.
+	// The issues of a linter for a file are processed in the same call (even when streamed).
+	sort.SliceStable(order, func(a, b int) bool {
+		return compareIssuePositions(&issues[order[a]], &issues[order[b]]) < 0
+	})
+
+	occurrences := map[[2]string]int{}
+
+	for _, i := range order {
+		fileKey := [2]string{issues[i].FilePath(), keys[i]}
+
+		issues[i].FingerprintID = hashFingerprint(keys[i], occurrences[fileKey])
+
+		occurrences[fileKey]++
+	}
+
+	return issues, nil
+}
+
+func (*Fingerprint) Finish() {}
+
+func (p *Fingerprint) key(issue *result.Issue) string {
+	sourceLine, err := p.lineCache.GetLine(issue.FilePath(), issue.Line())
+	if err != nil {
+		p.log.Infof("Failed to get line %s:%d from line cache: %s", issue.FilePath(), issue.Line(), err)
+	}
+
+	return fingerprintKey(issue, p.enclosingDecl(issue), sourceLine)
+}
+
+func (p *Fingerprint) enclosingDecl(issue *result.Issue) string {
+	decls, ok := p.decls[issue.FilePath()]
+	if !ok {
+		decls = p.parseDecls(issue.FilePath())
+		p.decls[issue.FilePath()] = decls
+	}
+
+	for _, decl := range decls {
+		if decl.from <= issue.Line() && issue.Line() <= decl.to {
+			return decl.name
+		}
+	}
+
+	return ""
+}
+
+func (p *Fingerprint) parseDecls(filePath string) []declRange {
+	if !strings.HasSuffix(filePath, ".go") {
+		return nil
+	}
+
+	// Don't keep the AST: only the ranges of the declarations are needed.
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filePath, nil, parser.SkipObjectResolution)
+	if err != nil {
+		p.log.Infof("Can't parse %s to find the declarations: %v", filePath, err)
+		return nil
+	}
+
+	var decls []declRange
+
+	for _, decl := range f.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			decls = append(decls, newDeclRange(fset, d, funcDeclName(d)))
+
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					decls = append(decls, newDeclRange(fset, s, s.Name.Name))
+				case *ast.ValueSpec:
+					decls = append(decls, newDeclRange(fset, s, s.Names[0].Name))
+				}
+			}
+		}
+	}
+
+	return decls
+}
+
+func newDeclRange(fset *token.FileSet, node ast.Node, name string) declRange {
+	return declRange{
+		name: name,
+		from: fset.Position(node.Pos()).Line,
+		to:   fset.Position(node.End()).Line,
+	}
+}
+
+// funcDeclName returns the name of a function, or `Type.Method` for a method.
+func funcDeclName(decl *ast.FuncDecl) string {
+	if decl.Recv == nil || len(decl.Recv.List) == 0 {
+		return decl.Name.Name
+	}
+
+	typ := decl.Recv.List[0].Type
+
+	for {
+		switch t := typ.(type) {
+		case *ast.StarExpr:
+			typ = t.X
+		case *ast.IndexExpr:
+			typ = t.X
+		case *ast.IndexListExpr:
+			typ = t.X
+		case *ast.Ident:
+			return t.Name + "." + decl.Name.Name
+		default:
+			return decl.Name.Name
+		}
+	}
+}
+
+// fingerprintKey identifies an issue without the occurrence index.
+// The numbers inside the text are ignored because they often contain positions or measures (length, complexity, etc.).
+func fingerprintKey(issue *result.Issue, decl, sourceLine string) string {
+	text := fingerprintDigitsRe.ReplaceAllString(strings.Join(strings.Fields(issue.Text), " "), "N")
+
+	// The whitespaces are removed to be column-insensitive.
+	context := strings.Join(strings.Fields(sourceLine), "")
+
+	return strings.Join([]string{issue.FromLinter, decl, text, context}, "\x00")
+}
+
+func hashFingerprint(key string, occurrence int) string {
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "%s\x00%d", key, occurrence)
+
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+func compareIssuePositions(a, b *result.Issue) int {
+	return cmp.Or(
+		strings.Compare(a.FilePath(), b.FilePath()),
+		cmp.Compare(a.Line(), b.Line()),
+		cmp.Compare(a.Column(), b.Column()),
+	)
+}
@@ -0,0 +1,138 @@
+package processors
+
+import (
+	"go/token"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func newFingerprintIssue(fromLinter, file string, line, column int, text string) result.Issue {
+	return result.Issue{
+		FromLinter: fromLinter,
+		Text:       text,
+		Pos:        token.Position{Filename: file, Line: line, Column: column},
+	}
+}
+
+func processFingerprints(t *testing.T, issues []result.Issue) []string {
+	t.Helper()
+
+	p := NewFingerprint(logutils.NewStderrLog(logutils.DebugKeyEmpty), fsutils.NewLineCache(fsutils.NewFileCache()))
+
+	got, err := p.Process(issues)
+	require.NoError(t, err)
+
+	var fingerprints []string
+	for i := range got {
+		require.NotEmpty(t, got[i].FingerprintID)
+
+		fingerprints = append(fingerprints, got[i].FingerprintID)
+	}
+
+	return fingerprints
+}
+
+func TestFingerprint_Process(t *testing.T) {
+	dir := t.TempDir()
+
+	fileA := filepath.Join(dir, "a.go")
+
+	err := os.WriteFile(fileA, []byte("package a\n\nfunc a() {\n\tfoo()\n\tfoo()\n}\n\nfunc (*T) b() {\n\tfoo()\n}\n"), 0o600)
+	require.NoError(t, err)
+
+	issues := []result.Issue{
+		newFingerprintIssue("errcheck", fileA, 5, 2, "Error return value is not checked"),
+		newFingerprintIssue("errcheck", fileA, 4, 2, "Error return value is not checked"),
+		newFingerprintIssue("errcheck", fileA, 9, 2, "Error return value is not checked"),
+		newFingerprintIssue("unused", fileA, 9, 2, "Error return value is not checked"),
+	}
+
+	fingerprints := processFingerprints(t, issues)
+
+	// Identical lines inside the same function, in other functions, and from other linters.
+	assert.Len(t, map[string]bool{
+		fingerprints[0]: true,
+		fingerprints[1]: true,
+		fingerprints[2]: true,
+		fingerprints[3]: true,
+	}, 4)
+
+	// The file is renamed, the lines are moved and re-indented, and another function is added before.
+	fileB := filepath.Join(dir, "b.go")
+
+	err = os.WriteFile(fileB, []byte("package a\n\nfunc c() {}\n\n// a does a.\nfunc a() {\n    foo()\n    foo()\n}\n\nfunc (t *T) b() {\n\tif true { foo() }\n}\n"), 0o600)
+	require.NoError(t, err)
+
+	movedIssues := []result.Issue{
+		newFingerprintIssue("errcheck", fileB, 7, 5, "Error return value is not checked"),
+		newFingerprintIssue("errcheck", fileB, 8, 5, "Error return value is not checked"),
+		newFingerprintIssue("errcheck", fileB, 12, 12, "Error return value is not checked"),
+	}
+
+	movedFingerprints := processFingerprints(t, movedIssues)
+
+	assert.Equal(t, fingerprints[1], movedFingerprints[0])
+	assert.Equal(t, fingerprints[0], movedFingerprints[1])
+
+	// The context of the issue has changed.
+	assert.NotEqual(t, fingerprints[2], movedFingerprints[2])
+}
+
+func TestFingerprint_Process_severalFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	fileA := filepath.Join(dir, "a.go")
+	fileB := filepath.Join(dir, "b.go")
+
+	for _, file := range []string{fileA, fileB} {
+		err := os.WriteFile(file, []byte("package a\n\nfunc a() {\n\tfoo()\n\tfoo()\n}\n"), 0o600)
+		require.NoError(t, err)
+	}
+
+	issues := []result.Issue{
+		newFingerprintIssue("errcheck", fileA, 5, 2, "Error return value is not checked"),
+		newFingerprintIssue("errcheck", fileB, 4, 2, "Error return value is not checked"),
+		newFingerprintIssue("errcheck", fileB, 5, 2, "Error return value is not checked"),
+	}
+
+	fingerprints := processFingerprints(t, slices.Clone(issues))
+
+	// The identical issues of different files have the same fingerprint.
+	assert.Equal(t, fingerprints[0], fingerprints[1])
+	assert.NotEqual(t, fingerprints[1], fingerprints[2])
+
+	// An issue is added in the file a.go: the fingerprints of b.go don't change.
+	issues = append(issues, newFingerprintIssue("errcheck", fileA, 4, 2, "Error return value is not checked"))
+
+	newFingerprints := processFingerprints(t, issues)
+
+	assert.Equal(t, fingerprints[1], newFingerprints[1])
+	assert.Equal(t, fingerprints[2], newFingerprints[2])
+}
+
+func Test_fingerprintKey(t *testing.T) {
+	issue := newFingerprintIssue("lll", "a.go", 10, 1, "the line is 125 characters long")
+
+	key := fingerprintKey(&issue, "a", "\tfoo()")
+
+	moved := newFingerprintIssue("lll", "b.go", 20, 4, "the line is 127 characters long")
+	assert.Equal(t, key, fingerprintKey(&moved, "a", "    foo( )"))
+
+	otherSource := newFingerprintIssue("lll", "a.go", 10, 1, "the line is 125 characters long")
+	assert.NotEqual(t, key, fingerprintKey(&otherSource, "a", "bar()"))
+
+	otherLinter := newFingerprintIssue("revive", "a.go", 10, 1, "the line is 125 characters long")
+	assert.NotEqual(t, key, fingerprintKey(&otherLinter, "a", "\tfoo()"))
+
+	otherDecl := newFingerprintIssue("lll", "a.go", 10, 1, "the line is 125 characters long")
+	assert.NotEqual(t, key, fingerprintKey(&otherDecl, "b", "\tfoo()"))
+}
@@ -2,12 +2,20 @@ package processors
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 
+	"github.com/hexops/gotextdiff"
+	"github.com/hexops/gotextdiff/myers"
+	"github.com/hexops/gotextdiff/span"
+	"golang.org/x/exp/maps"
+
 	"github.com/golangci/golangci-lint/internal/robustio"
 	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/fsutils"
@@ -16,6 +24,8 @@ import (
 	"github.com/golangci/golangci-lint/pkg/timeutils"
 )
 
+const diffFileMode = 0o644
+
 var _ Processor = (*Fixer)(nil)
 
 type Fixer struct {
@@ -44,30 +54,40 @@ func (p Fixer) Process(issues []result.Issue) ([]result.Issue, error) {
 	}
 
 	outIssues := make([]result.Issue, 0, len(issues))
-	issuesToFixPerFile := map[string][]result.Issue{}
+	var issuesToFix []result.Issue
 	for i := range issues {
 		issue := &issues[i]
-		if issue.Replacement == nil {
+		if !isFixable(issue) {
 			outIssues = append(outIssues, *issue)
 			continue
 		}
 
-		issuesToFixPerFile[issue.FilePath()] = append(issuesToFixPerFile[issue.FilePath()], *issue)
+		issuesToFix = append(issuesToFix, *issue)
 	}
 
-	for file, issuesToFix := range issuesToFixPerFile {
+	if p.cfg.Issues.FixDiff {
 		var err error
 		p.sw.TrackStage("all", func() {
-			err = p.fixIssuesInFile(file, issuesToFix)
+			err = p.printDiffs(issuesToFix)
 		})
 		if err != nil {
-			p.log.Errorf("Failed to fix issues in file %s: %s", file, err)
-
-			// show issues only if can't fix them
-			outIssues = append(outIssues, issuesToFix...)
+			p.log.Errorf("Failed to print fixes as diff: %s", err)
 		}
+
+		p.printStat()
+
+		// The files are not modified: all the issues are still relevant.
+		return issues, nil
 	}
 
+	var notFixedIssues []result.Issue
+	p.sw.TrackStage("all", func() {
+		notFixedIssues = p.fixIssues(issuesToFix)
+	})
+
+	// show issues only if can't fix them
+	outIssues = append(outIssues, notFixedIssues...)
+
 	p.printStat()
 
 	return outIssues, nil
@@ -75,16 +95,324 @@ func (p Fixer) Process(issues []result.Issue) ([]result.Issue, error) {
 
 func (Fixer) Finish() {}
 
-func (p Fixer) fixIssuesInFile(filePath string, issues []result.Issue) error {
+// fixIssues applies the fixes of the issues and returns the issues that cannot be fixed.
+// The fixed content of every file is computed before writing any file:
+// the fix of an issue editing several files is never partially applied.
+func (p Fixer) fixIssues(issues []result.Issue) []result.Issue {
+	notFixed := map[int]bool{}
+
+	var (
+		fixedFiles   map[string][]byte
+		editedIssues map[string][]int
+	)
+
+	for {
+		var edits map[string][]result.TextEdit
+		edits, editedIssues = p.findFileEdits(issues, notFixed)
+
+		var failed bool
+		fixedFiles, failed = p.computeFixedFiles(edits, editedIssues, notFixed)
+
+		// The issues of a failed file are not fixed:
+		// the edits are computed again without them, so their edits of the other files are not applied.
+		if !failed {
+			break
+		}
+	}
+
+	filePaths := maps.Keys(fixedFiles)
+	sort.Strings(filePaths)
+
+	for _, filePath := range filePaths {
+		if err := writeFixedFile(filePath, fixedFiles[filePath]); err != nil {
+			p.log.Errorf("Failed to fix issues in file %s: %s", filePath, err)
+
+			for _, ind := range editedIssues[filePath] {
+				notFixed[ind] = true
+			}
+		}
+	}
+
+	var notFixedIssues []result.Issue
+	for i := range issues {
+		if notFixed[i] {
+			notFixedIssues = append(notFixedIssues, issues[i])
+		}
+	}
+
+	return notFixedIssues
+}
+
+// computeFixedFiles applies the edits in memory.
+// The issues editing a file that cannot be fixed are marked as not fixed: it returns true in this case.
+func (p Fixer) computeFixedFiles(edits map[string][]result.TextEdit, editedIssues map[string][]int,
+	notFixed map[int]bool,
+) (fixedFiles map[string][]byte, failed bool) {
+	fixedFiles = map[string][]byte{}
+
+	for filePath, fileEdits := range edits {
+		fixedData, err := p.fixFile(filePath, fileEdits)
+		if err != nil {
+			p.log.Errorf("Failed to fix issues in file %s: %s", filePath, err)
+
+			for _, ind := range editedIssues[filePath] {
+				notFixed[ind] = true
+			}
+
+			failed = true
+
+			continue
+		}
+
+		fixedFiles[filePath] = fixedData
+	}
+
+	return fixedFiles, failed
+}
+
+// printDiffs prints the fixes of the issues as a unified diff per file, without modifying the files.
+func (p Fixer) printDiffs(issues []result.Issue) error {
+	edits, _ := p.findFileEdits(issues, nil)
+
+	w, shouldClose, err := createDiffWriter(p.cfg.Issues.FixDiffPath)
+	if err != nil {
+		return fmt.Errorf("can't create output for %s: %w", p.cfg.Issues.FixDiffPath, err)
+	}
+
+	defer func() {
+		if file, ok := w.(io.Closer); shouldClose && ok {
+			_ = file.Close()
+		}
+	}()
+
+	filePaths := maps.Keys(edits)
+	sort.Strings(filePaths)
+
+	for _, filePath := range filePaths {
+		origFileData, err := p.fileCache.GetFileBytes(filePath)
+		if err != nil {
+			return fmt.Errorf("failed to get file bytes for %s: %w", filePath, err)
+		}
+
+		fixedData, err := applyEdits(origFileData, edits[filePath])
+		if err != nil {
+			return fmt.Errorf("failed to compute fixes of %s: %w", filePath, err)
+		}
+
+		if err := writeUnifiedDiff(w, filePath, string(origFileData), string(fixedData)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findFileEdits computes the non-intersecting edits to apply on each file.
+// It also returns, for each file, the indexes of the issues editing it.
+// The issues that cannot be fixed (invalid or intersecting edits) are added to notFixed (if not nil), and are ignored.
+func (p Fixer) findFileEdits(issues []result.Issue, notFixed map[int]bool) (
+	edits map[string][]result.TextEdit, editedIssues map[string][]int,
+) {
+	indexes := make([]int, len(issues))
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	sort.SliceStable(indexes, func(i, j int) bool {
+		a, b := &issues[indexes[i]], &issues[indexes[j]]
+		if a.FilePath() != b.FilePath() {
+			return a.FilePath() < b.FilePath()
+		}
+		return a.Line() < b.Line()
+	})
+
+	edits = map[string][]result.TextEdit{}
+	editedIssues = map[string][]int{}
+
+	for _, ind := range indexes {
+		if notFixed[ind] {
+			continue
+		}
+
+		issue := &issues[ind]
+
+		issueEdits, err := p.buildIssueEdits(issue)
+		if err != nil {
+			p.log.Warnf("Skip fix of issue %s:%d (%s): %v", issue.FilePath(), issue.Line(), issue.FromLinter, err)
+			markNotFixed(notFixed, ind)
+
+			continue
+		}
+
+		if findIntersectingEdit(edits, issueEdits) {
+			if p.isSuperseded(edits, issueEdits) {
+				p.log.Infof("Skip issue %#v: its code is already rewritten by another fix", issue)
+
+				continue
+			}
+
+			p.log.Infof("Skip issue %#v: its fix intersects with another fix", issue)
+			markNotFixed(notFixed, ind)
+
+			continue
+		}
+
+		p.log.Infof("Fix issue %#v with %d edits", issue, len(issueEdits))
+
+		for _, edit := range issueEdits {
+			if !slices.Contains(edits[edit.Filename], edit) {
+				edits[edit.Filename] = append(edits[edit.Filename], edit)
+			}
+
+			if inds := editedIssues[edit.Filename]; len(inds) == 0 || inds[len(inds)-1] != ind {
+				editedIssues[edit.Filename] = append(editedIssues[edit.Filename], ind)
+			}
+		}
+	}
+
+	return edits, editedIssues
+}
+
+// buildIssueEdits converts the fix of an issue into edits with normalized file paths.
+// The first suggested fix is preferred: the other ones are alternatives.
+func (p Fixer) buildIssueEdits(issue *result.Issue) ([]result.TextEdit, error) {
+	var edits []result.TextEdit
+
+	if len(issue.SuggestedFixes) > 0 {
+		edits = slices.Clone(issue.SuggestedFixes[0].TextEdits)
+	} else {
+		fileData, err := p.fileCache.GetFileBytes(issue.FilePath())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get file bytes for %s: %w", issue.FilePath(), err)
+		}
+
+		edit, err := ReplacementToEdit(issue, fileData)
+		if err != nil {
+			return nil, err
+		}
+
+		edits = append(edits, edit)
+	}
+
+	for i := range edits {
+		if edits[i].Start < 0 || edits[i].Start > edits[i].End {
+			return nil, fmt.Errorf("invalid edit range [%d, %d)", edits[i].Start, edits[i].End)
+		}
+
+		filePath, err := normalizeFixPath(edits[i].Filename)
+		if err != nil {
+			return nil, err
+		}
+
+		edits[i].Filename = filePath
+	}
+
+	return edits, nil
+}
+
+// isSuperseded checks if the accepted edits already rewrite the code of the edits of an issue:
+// every edit is inside an accepted edit (e.g. the hunks of two formatters),
+// or the accepted edits produce the same content (e.g. two linters fixing the same comment).
+// The issue is reported by the next run if it is still relevant.
+func (p Fixer) isSuperseded(accepted map[string][]result.TextEdit, edits []result.TextEdit) bool {
+	for _, edit := range edits {
+		if slices.ContainsFunc(accepted[edit.Filename], func(other result.TextEdit) bool {
+			return editContains(other, edit)
+		}) {
+			continue
+		}
+
+		data, err := p.fileCache.GetFileBytes(edit.Filename)
+		if err != nil {
+			return false
+		}
+
+		if !sameEditedRegion(data, accepted[edit.Filename], edit) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sameEditedRegion checks if the edit produces the same content as the accepted edits intersecting with it.
+// The compared region is extended until it contains all the intersecting edits.
+func sameEditedRegion(data []byte, accepted []result.TextEdit, edit result.TextEdit) bool {
+	region := result.TextEdit{Start: edit.Start, End: edit.End}
+
+	var intersecting []result.TextEdit
+
+	for extended := true; extended; {
+		extended = false
+		intersecting = intersecting[:0]
+
+		for _, other := range accepted {
+			if !editsIntersect(region, other) {
+				continue
+			}
+
+			intersecting = append(intersecting, other)
+
+			if other.Start < region.Start || other.End > region.End {
+				region.Start = min(region.Start, other.Start)
+				region.End = max(region.End, other.End)
+				extended = true
+			}
+		}
+	}
+
+	if region.End > len(data) {
+		return false
+	}
+
+	expected, err := applyEdits(data[region.Start:region.End], shiftEdits(intersecting, -region.Start))
+	if err != nil {
+		return false
+	}
+
+	actual, err := applyEdits(data[region.Start:region.End], shiftEdits([]result.TextEdit{edit}, -region.Start))
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(expected, actual)
+}
+
+// editContains checks if the range of the edit a contains the range of the edit b.
+// An insertion at a bound of the range is not contained.
+func editContains(a, b result.TextEdit) bool {
+	if b.Start == b.End {
+		return a.Start < b.Start && b.Start < a.End
+	}
+
+	return a.Start <= b.Start && b.End <= a.End
+}
+
+func shiftEdits(edits []result.TextEdit, offset int) []result.TextEdit {
+	shifted := make([]result.TextEdit, len(edits))
+
+	for i, edit := range edits {
+		edit.Start += offset
+		edit.End += offset
+		shifted[i] = edit
+	}
+
+	return shifted
+}
+
+// fixFile returns the content of the file with the edits applied.
+func (p Fixer) fixFile(filePath string, edits []result.TextEdit) ([]byte, error) {
 	// TODO: don't read the whole file into memory: read line by line;
 	// can't just use bufio.scanner: it has a line length limit
 	origFileData, err := p.fileCache.GetFileBytes(filePath)
 	if err != nil {
-		return fmt.Errorf("failed to get file bytes for %s: %w", filePath, err)
+		return nil, fmt.Errorf("failed to get file bytes for %s: %w", filePath, err)
 	}
 
-	origFileLines := bytes.Split(origFileData, []byte("\n"))
+	return applyEdits(origFileData, edits)
+}
 
+func writeFixedFile(filePath string, fixedData []byte) error {
 	tmpFileName := filepath.Join(filepath.Dir(filePath), fmt.Sprintf(".%s.golangci_fix", filepath.Base(filePath)))
 
 	tmpOutFile, err := os.Create(tmpFileName)
@@ -92,26 +420,10 @@ func (p Fixer) fixIssuesInFile(filePath string, issues []result.Issue) error {
 		return fmt.Errorf("failed to make file %s: %w", tmpFileName, err)
 	}
 
-	// merge multiple issues per line into one issue
-	issuesPerLine := map[int][]result.Issue{}
-	for i := range issues {
-		issue := &issues[i]
-		issuesPerLine[issue.Line()] = append(issuesPerLine[issue.Line()], *issue)
-	}
-
-	issues = issues[:0] // reuse the same memory
-	for line, lineIssues := range issuesPerLine {
-		if mergedIssue := p.mergeLineIssues(line, lineIssues, origFileLines); mergedIssue != nil {
-			issues = append(issues, *mergedIssue)
-		}
-	}
-
-	issues = p.findNotIntersectingIssues(issues)
-
-	if err = p.writeFixedFile(origFileLines, issues, tmpOutFile); err != nil {
+	if _, err = tmpOutFile.Write(fixedData); err != nil {
 		tmpOutFile.Close()
 		_ = robustio.RemoveAll(tmpOutFile.Name())
-		return err
+		return fmt.Errorf("failed to write fixed file: %w", err)
 	}
 
 	tmpOutFile.Close()
@@ -124,138 +436,197 @@ func (p Fixer) fixIssuesInFile(filePath string, issues []result.Issue) error {
 	return nil
 }
 
-func (p Fixer) mergeLineIssues(lineNum int, lineIssues []result.Issue, origFileLines [][]byte) *result.Issue {
-	origLine := origFileLines[lineNum-1] // lineNum is 1-based
+func (p Fixer) printStat() {
+	p.sw.PrintStages()
+}
 
-	if len(lineIssues) == 1 && lineIssues[0].Replacement.Inline == nil {
-		return &lineIssues[0]
+func createDiffWriter(path string) (io.Writer, bool, error) {
+	if path == "" || path == "stdout" {
+		return logutils.StdOut, false, nil
 	}
 
-	// check issues first
-	for ind := range lineIssues {
-		li := &lineIssues[ind]
+	if path == "stderr" {
+		return logutils.StdErr, false, nil
+	}
 
-		if li.LineRange != nil {
-			p.log.Infof("Line %d has multiple issues but at least one of them is ranged: %#v", lineNum, lineIssues)
-			return &lineIssues[0]
-		}
+	err := os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err != nil {
+		return nil, false, err
+	}
 
-		inline := li.Replacement.Inline
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, diffFileMode)
+	if err != nil {
+		return nil, false, err
+	}
 
-		if inline == nil || len(li.Replacement.NewLines) != 0 || li.Replacement.NeedOnlyDelete {
-			p.log.Infof("Line %d has multiple issues but at least one of them isn't inline: %#v", lineNum, lineIssues)
-			return li
-		}
+	return f, true, nil
+}
 
-		if inline.StartCol < 0 || inline.Length <= 0 || inline.StartCol+inline.Length > len(origLine) {
-			p.log.Warnf("Line %d (%q) has invalid inline fix: %#v, %#v", lineNum, origLine, li, inline)
-			return nil
-		}
+// writeUnifiedDiff writes a git-style unified diff (a/ and b/ prefixes) between the original and the fixed content.
+func writeUnifiedDiff(w io.Writer, filePath, orig, fixed string) error {
+	name := filepath.ToSlash(filePath)
+
+	edits := myers.ComputeEdits(span.URIFromPath(filePath), orig, fixed)
+	unified := gotextdiff.ToUnified("a/"+name, "b/"+name, orig, edits)
+
+	if _, err := fmt.Fprint(w, unified); err != nil {
+		return fmt.Errorf("failed to write diff of %s: %w", filePath, err)
 	}
 
-	return p.applyInlineFixes(lineIssues, origLine, lineNum)
+	return nil
 }
 
-func (p Fixer) applyInlineFixes(lineIssues []result.Issue, origLine []byte, lineNum int) *result.Issue {
-	sort.Slice(lineIssues, func(i, j int) bool {
-		return lineIssues[i].Replacement.Inline.StartCol < lineIssues[j].Replacement.Inline.StartCol
-	})
+// markNotFixed marks an issue as not fixed, if the issues are tracked.
+func markNotFixed(notFixed map[int]bool, ind int) {
+	if notFixed != nil {
+		notFixed[ind] = true
+	}
+}
+
+func isFixable(issue *result.Issue) bool {
+	return issue.Replacement != nil || len(issue.SuggestedFixes) > 0
+}
 
-	var newLineBuf bytes.Buffer
-	newLineBuf.Grow(len(origLine))
+// ReplacementToEdit converts the line-based replacement of an issue into a byte-offset edit.
+func ReplacementToEdit(issue *result.Issue, fileData []byte) (result.TextEdit, error) {
+	lineOffsets := computeLineOffsets(fileData)
+	nbLines := len(lineOffsets)
 
-	//nolint:misspell // misspelling is intentional
-	// example: origLine="it's becouse of them", StartCol=5, Length=7, NewString="because"
+	edit := result.TextEdit{Filename: issue.FilePath()}
 
-	curOrigLinePos := 0
-	for i := range lineIssues {
-		fix := lineIssues[i].Replacement.Inline
-		if fix.StartCol < curOrigLinePos {
-			p.log.Warnf("Line %d has multiple intersecting issues: %#v", lineNum, lineIssues)
-			return nil
+	if inline := issue.Replacement.Inline; inline != nil {
+		if issue.Line() < 1 || issue.Line() > nbLines {
+			return edit, fmt.Errorf("invalid line %d", issue.Line())
 		}
 
-		if curOrigLinePos != fix.StartCol {
-			newLineBuf.Write(origLine[curOrigLinePos:fix.StartCol])
+		lineStart := lineOffsets[issue.Line()-1]
+		lineEnd := len(fileData)
+		if issue.Line() < nbLines {
+			lineEnd = lineOffsets[issue.Line()] - 1
 		}
-		newLineBuf.WriteString(fix.NewString)
-		curOrigLinePos = fix.StartCol + fix.Length
+
+		if inline.StartCol < 0 || inline.Length <= 0 || lineStart+inline.StartCol+inline.Length > lineEnd {
+			return edit, fmt.Errorf("invalid inline fix: %#v", inline)
+		}
+
+		edit.Start = lineStart + inline.StartCol
+		edit.End = edit.Start + inline.Length
+		edit.NewText = inline.NewString
+
+		return edit, nil
 	}
-	if curOrigLinePos != len(origLine) {
-		newLineBuf.Write(origLine[curOrigLinePos:])
+
+	rng := issue.GetLineRange()
+	if rng.From < 1 || rng.From > rng.To || rng.To > nbLines {
+		return edit, fmt.Errorf("invalid line range (from=%d, to=%d)", rng.From, rng.To)
+	}
+
+	edit.Start = lineOffsets[rng.From-1]
+	edit.End = len(fileData)
+	if rng.To < nbLines {
+		edit.End = lineOffsets[rng.To]
 	}
 
-	mergedIssue := lineIssues[0] // use text from the first issue (it's not really used)
-	mergedIssue.Replacement = &result.Replacement{
-		NewLines: []string{newLineBuf.String()},
+	if !issue.Replacement.NeedOnlyDelete {
+		edit.NewText = strings.Join(issue.Replacement.NewLines, "\n")
+		if rng.To < nbLines {
+			edit.NewText += "\n"
+		}
 	}
-	return &mergedIssue
+
+	return edit, nil
 }
 
-func (p Fixer) findNotIntersectingIssues(issues []result.Issue) []result.Issue {
-	sort.SliceStable(issues, func(i, j int) bool {
-		a, b := issues[i], issues[j]
-		return a.Line() < b.Line()
+// computeLineOffsets returns the offset of the first byte of each line.
+func computeLineOffsets(data []byte) []int {
+	offsets := []int{0}
+	for i, b := range data {
+		if b == '\n' {
+			offsets = append(offsets, i+1)
+		}
+	}
+
+	return offsets
+}
+
+// applyEdits applies non-intersecting edits on the data.
+func applyEdits(data []byte, edits []result.TextEdit) ([]byte, error) {
+	sorted := make([]result.TextEdit, len(edits))
+	copy(sorted, edits)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Start != sorted[j].Start {
+			return sorted[i].Start < sorted[j].Start
+		}
+		return sorted[i].End < sorted[j].End
 	})
 
-	var ret []result.Issue
-	var currentEnd int
-	for i := range issues {
-		issue := &issues[i]
-		rng := issue.GetLineRange()
-		if rng.From <= currentEnd {
-			p.log.Infof("Skip issue %#v: intersects with end %d", issue, currentEnd)
-			continue // skip intersecting issue
+	var buf bytes.Buffer
+	buf.Grow(len(data))
+
+	lastEnd := 0
+	for _, edit := range sorted {
+		if edit.Start < lastEnd {
+			return nil, errors.New("edits are intersecting")
+		}
+
+		if edit.End > len(data) {
+			return nil, fmt.Errorf("edit range [%d, %d) is out of the file (size %d)", edit.Start, edit.End, len(data))
 		}
-		p.log.Infof("Fix issue %#v with range %v", issue, issue.GetLineRange())
-		ret = append(ret, *issue)
-		currentEnd = rng.To
+
+		buf.Write(data[lastEnd:edit.Start])
+		buf.WriteString(edit.NewText)
+		lastEnd = edit.End
 	}
 
-	return ret
+	buf.Write(data[lastEnd:])
+
+	return buf.Bytes(), nil
 }
 
-func (p Fixer) writeFixedFile(origFileLines [][]byte, issues []result.Issue, tmpOutFile *os.File) error {
-	// issues aren't intersecting
-
-	nextIssueIndex := 0
-	for i := 0; i < len(origFileLines); i++ {
-		var outLine string
-		var nextIssue *result.Issue
-		if nextIssueIndex != len(issues) {
-			nextIssue = &issues[nextIssueIndex]
-		}
-
-		origFileLineNumber := i + 1
-		if nextIssue == nil || origFileLineNumber != nextIssue.GetLineRange().From {
-			outLine = string(origFileLines[i])
-		} else {
-			nextIssueIndex++
-			rng := nextIssue.GetLineRange()
-			if rng.From > rng.To {
-				// Maybe better decision is to skip such issues, re-evaluate if regressed.
-				p.log.Warnf("[fixer]: issue line range is probably invalid, fix can be incorrect (from=%d, to=%d, linter=%s)",
-					rng.From, rng.To, nextIssue.FromLinter,
-				)
-			}
-			i += rng.To - rng.From
-			if nextIssue.Replacement.NeedOnlyDelete {
+// findIntersectingEdit checks if one of the edits intersects with the already accepted edits.
+// Identical edits are not considered as intersecting.
+func findIntersectingEdit(accepted map[string][]result.TextEdit, edits []result.TextEdit) bool {
+	for i, edit := range edits {
+		for _, other := range accepted[edit.Filename] {
+			if edit == other {
 				continue
 			}
-			outLine = strings.Join(nextIssue.Replacement.NewLines, "\n")
-		}
 
-		if i < len(origFileLines)-1 {
-			outLine += "\n"
+			if editsIntersect(edit, other) {
+				return true
+			}
 		}
-		if _, err := tmpOutFile.WriteString(outLine); err != nil {
-			return fmt.Errorf("failed to write output line: %w", err)
+
+		// The edits of the same fix must not intersect between themselves.
+		for _, other := range edits[i+1:] {
+			if edit.Filename == other.Filename && edit != other && editsIntersect(edit, other) {
+				return true
+			}
 		}
 	}
 
-	return nil
+	return false
 }
 
-func (p Fixer) printStat() {
-	p.sw.PrintStages()
+func editsIntersect(a, b result.TextEdit) bool {
+	if a.Start == a.End && b.Start == b.End {
+		// Two different insertions at the same position: the order is ambiguous.
+		return a.Start == b.Start
+	}
+
+	return a.Start < b.End && b.Start < a.End
+}
+
+func normalizeFixPath(filePath string) (string, error) {
+	if !filepath.IsAbs(filePath) {
+		return filePath, nil
+	}
+
+	rel, err := fsutils.ShortestRelPath(filePath, "")
+	if err != nil {
+		return "", fmt.Errorf("failed to normalize path %s: %w", filePath, err)
+	}
+
+	return rel, nil
 }
@@ -0,0 +1,416 @@
+package processors
+
+import (
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestFixer_Process(t *testing.T) {
+	dir := t.TempDir()
+
+	fileA := filepath.Join(dir, "a.go")
+	fileB := filepath.Join(dir, "b.go")
+
+	err := os.WriteFile(fileA, []byte("package a\n\nvar x = foo(1)\nvar y = bar\n"), 0o600)
+	require.NoError(t, err)
+
+	err = os.WriteFile(fileB, []byte("package a\n\nfunc foo(int) int { return 0 }\n"), 0o600)
+	require.NoError(t, err)
+
+	cfg := &config.Config{}
+	cfg.Issues.NeedFix = true
+
+	issues := []result.Issue{
+		{
+			// multi-file fix.
+			FromLinter: "a",
+			Pos:        token.Position{Filename: fileA, Line: 3},
+			SuggestedFixes: []result.SuggestedFix{{TextEdits: []result.TextEdit{
+				{Filename: fileA, Start: 19, End: 22, NewText: "baz"},
+				{Filename: fileB, Start: 16, End: 19, NewText: "baz"},
+			}}},
+		},
+		{
+			// intersects with the previous fix.
+			FromLinter: "b",
+			Pos:        token.Position{Filename: fileA, Line: 3},
+			SuggestedFixes: []result.SuggestedFix{{TextEdits: []result.TextEdit{
+				{Filename: fileA, Start: 19, End: 25, NewText: "1"},
+			}}},
+		},
+		{
+			// line replacement.
+			FromLinter:  "c",
+			Pos:         token.Position{Filename: fileA, Line: 4},
+			Replacement: &result.Replacement{NewLines: []string{"var y = qux"}},
+		},
+		{
+			FromLinter: "d",
+			Pos:        token.Position{Filename: fileA, Line: 1},
+		},
+	}
+
+	p := NewFixer(cfg, logutils.NewStderrLog(logutils.DebugKeyEmpty), fsutils.NewFileCache())
+
+	got, err := p.Process(issues)
+	require.NoError(t, err)
+
+	// The intersecting fix is not applied: the issue is still reported.
+	require.Len(t, got, 2)
+	assert.Equal(t, "d", got[0].FromLinter)
+	assert.Equal(t, "b", got[1].FromLinter)
+
+	data, err := os.ReadFile(fileA)
+	require.NoError(t, err)
+
+	assert.Equal(t, "package a\n\nvar x = baz(1)\nvar y = qux\n", string(data))
+
+	data, err = os.ReadFile(fileB)
+	require.NoError(t, err)
+
+	assert.Equal(t, "package a\n\nfunc baz(int) int { return 0 }\n", string(data))
+}
+
+func TestFixer_Process_multiFileError(t *testing.T) {
+	dir := t.TempDir()
+
+	fileA := filepath.Join(dir, "a.go")
+	fileB := filepath.Join(dir, "b.go")
+
+	err := os.WriteFile(fileA, []byte("package a\n\nvar x = foo(1)\n"), 0o600)
+	require.NoError(t, err)
+
+	err = os.WriteFile(fileB, []byte("package a\n"), 0o600)
+	require.NoError(t, err)
+
+	cfg := &config.Config{}
+	cfg.Issues.NeedFix = true
+
+	issues := []result.Issue{
+		{
+			// the edit of b.go is out of the file.
+			FromLinter: "a",
+			Pos:        token.Position{Filename: fileA, Line: 3},
+			SuggestedFixes: []result.SuggestedFix{{TextEdits: []result.TextEdit{
+				{Filename: fileA, Start: 19, End: 22, NewText: "baz"},
+				{Filename: fileB, Start: 100, End: 103, NewText: "baz"},
+			}}},
+		},
+		{
+			// invalid edit.
+			FromLinter: "b",
+			Pos:        token.Position{Filename: fileA, Line: 1},
+			SuggestedFixes: []result.SuggestedFix{{TextEdits: []result.TextEdit{
+				{Filename: fileA, Start: 5, End: 2, NewText: "x"},
+			}}},
+		},
+	}
+
+	p := NewFixer(cfg, logutils.NewStderrLog(logutils.DebugKeyEmpty), fsutils.NewFileCache())
+
+	got, err := p.Process(issues)
+	require.NoError(t, err)
+
+	require.Len(t, got, 2)
+	assert.Equal(t, "a", got[0].FromLinter)
+	assert.Equal(t, "b", got[1].FromLinter)
+
+	// No file is modified.
+	data, err := os.ReadFile(fileA)
+	require.NoError(t, err)
+
+	assert.Equal(t, "package a\n\nvar x = foo(1)\n", string(data))
+
+	data, err = os.ReadFile(fileB)
+	require.NoError(t, err)
+
+	assert.Equal(t, "package a\n", string(data))
+}
+
+func TestFixer_Process_superseded(t *testing.T) {
+	dir := t.TempDir()
+
+	fileA := filepath.Join(dir, "a.go")
+
+	err := os.WriteFile(fileA, []byte("package a\n\n//comment\nvar x = 1\nvar y=2\n"), 0o600)
+	require.NoError(t, err)
+
+	cfg := &config.Config{}
+	cfg.Issues.NeedFix = true
+
+	issues := []result.Issue{
+		{
+			FromLinter: "a",
+			Pos:        token.Position{Filename: fileA, Line: 3},
+			SuggestedFixes: []result.SuggestedFix{{TextEdits: []result.TextEdit{
+				{Filename: fileA, Start: 13, End: 13, NewText: " "},
+			}}},
+		},
+		{
+			// same result as the previous fix.
+			FromLinter:  "b",
+			Pos:         token.Position{Filename: fileA, Line: 3},
+			Replacement: &result.Replacement{NewLines: []string{"// comment"}},
+		},
+		{
+			// other result.
+			FromLinter:  "c",
+			Pos:         token.Position{Filename: fileA, Line: 3},
+			Replacement: &result.Replacement{NewLines: []string{"// other"}},
+		},
+		{
+			FromLinter:  "d",
+			Pos:         token.Position{Filename: fileA, Line: 5},
+			Replacement: &result.Replacement{NewLines: []string{"var y = 2"}},
+		},
+		{
+			// inside the previous fix.
+			FromLinter: "e",
+			Pos:        token.Position{Filename: fileA, Line: 5},
+			SuggestedFixes: []result.SuggestedFix{{TextEdits: []result.TextEdit{
+				{Filename: fileA, Start: 36, End: 37, NewText: " = "},
+			}}},
+		},
+	}
+
+	p := NewFixer(cfg, logutils.NewStderrLog(logutils.DebugKeyEmpty), fsutils.NewFileCache())
+
+	got, err := p.Process(issues)
+	require.NoError(t, err)
+
+	require.Len(t, got, 1)
+	assert.Equal(t, "c", got[0].FromLinter)
+
+	data, err := os.ReadFile(fileA)
+	require.NoError(t, err)
+
+	assert.Equal(t, "package a\n\n// comment\nvar x = 1\nvar y = 2\n", string(data))
+}
+
+func TestReplacementToEdit(t *testing.T) {
+	fileData := []byte("line1\nline2\nline3")
+
+	testCases := []struct {
+		desc     string
+		issue    *result.Issue
+		expected result.TextEdit
+	}{
+		{
+			desc: "inline",
+			issue: &result.Issue{
+				Pos:         token.Position{Filename: "a.go", Line: 2},
+				Replacement: &result.Replacement{Inline: &result.InlineFix{StartCol: 1, Length: 3, NewString: "xx"}},
+			},
+			expected: result.TextEdit{Filename: "a.go", Start: 7, End: 10, NewText: "xx"},
+		},
+		{
+			desc: "lines",
+			issue: &result.Issue{
+				Pos:         token.Position{Filename: "a.go", Line: 1},
+				LineRange:   &result.Range{From: 1, To: 2},
+				Replacement: &result.Replacement{NewLines: []string{"a", "b", "c"}},
+			},
+			expected: result.TextEdit{Filename: "a.go", Start: 0, End: 12, NewText: "a\nb\nc\n"},
+		},
+		{
+			desc: "last line",
+			issue: &result.Issue{
+				Pos:         token.Position{Filename: "a.go", Line: 3},
+				Replacement: &result.Replacement{NewLines: []string{"a"}},
+			},
+			expected: result.TextEdit{Filename: "a.go", Start: 12, End: 17, NewText: "a"},
+		},
+		{
+			desc: "delete",
+			issue: &result.Issue{
+				Pos:         token.Position{Filename: "a.go", Line: 2},
+				Replacement: &result.Replacement{NeedOnlyDelete: true},
+			},
+			expected: result.TextEdit{Filename: "a.go", Start: 6, End: 12},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			edit, err := ReplacementToEdit(test.issue, fileData)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, edit)
+		})
+	}
+}
+
+func TestReplacementToEdit_error(t *testing.T) {
+	fileData := []byte("line1\nline2\nline3")
+
+	testCases := []struct {
+		desc  string
+		issue *result.Issue
+	}{
+		{
+			desc: "inline out of the line",
+			issue: &result.Issue{
+				Pos:         token.Position{Filename: "a.go", Line: 1},
+				Replacement: &result.Replacement{Inline: &result.InlineFix{StartCol: 3, Length: 5}},
+			},
+		},
+		{
+			desc: "inverted range",
+			issue: &result.Issue{
+				Pos:         token.Position{Filename: "a.go", Line: 2},
+				LineRange:   &result.Range{From: 2, To: 1},
+				Replacement: &result.Replacement{NeedOnlyDelete: true},
+			},
+		},
+		{
+			desc: "line out of the file",
+			issue: &result.Issue{
+				Pos:         token.Position{Filename: "a.go", Line: 4},
+				Replacement: &result.Replacement{NeedOnlyDelete: true},
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ReplacementToEdit(test.issue, fileData)
+			require.Error(t, err)
+		})
+	}
+}
+
+func Test_findIntersectingEdit(t *testing.T) {
+	accepted := map[string][]result.TextEdit{
+		"a.go": {{Filename: "a.go", Start: 10, End: 20, NewText: "x"}},
+	}
+
+	testCases := []struct {
+		desc     string
+		edits    []result.TextEdit
+		expected bool
+	}{
+		{
+			desc:  "before",
+			edits: []result.TextEdit{{Filename: "a.go", Start: 0, End: 10}},
+		},
+		{
+			desc:  "after",
+			edits: []result.TextEdit{{Filename: "a.go", Start: 20, End: 25}},
+		},
+		{
+			desc:  "other file",
+			edits: []result.TextEdit{{Filename: "b.go", Start: 10, End: 20}},
+		},
+		{
+			desc:  "identical",
+			edits: []result.TextEdit{{Filename: "a.go", Start: 10, End: 20, NewText: "x"}},
+		},
+		{
+			desc:     "overlap",
+			edits:    []result.TextEdit{{Filename: "a.go", Start: 15, End: 25}},
+			expected: true,
+		},
+		{
+			desc:     "same range, different text",
+			edits:    []result.TextEdit{{Filename: "a.go", Start: 10, End: 20, NewText: "y"}},
+			expected: true,
+		},
+		{
+			desc: "self intersecting",
+			edits: []result.TextEdit{
+				{Filename: "b.go", Start: 0, End: 5},
+				{Filename: "b.go", Start: 3, End: 8},
+			},
+			expected: true,
+		},
+		{
+			desc: "insertions at the same position",
+			edits: []result.TextEdit{
+				{Filename: "b.go", Start: 3, End: 3, NewText: "a"},
+				{Filename: "b.go", Start: 3, End: 3, NewText: "b"},
+			},
+			expected: true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, findIntersectingEdit(accepted, test.edits))
+		})
+	}
+}
+
+func TestFixer_Process_diff(t *testing.T) {
+	dir := t.TempDir()
+
+	file := filepath.Join(dir, "a.go")
+
+	content := "package a\n\nvar x = foo(1)\nvar y = bar\n"
+
+	err := os.WriteFile(file, []byte(content), 0o600)
+	require.NoError(t, err)
+
+	cfg := &config.Config{}
+	cfg.Issues.NeedFix = true
+	cfg.Issues.FixDiff = true
+	cfg.Issues.FixDiffPath = filepath.Join(dir, "fixes.diff")
+
+	issues := []result.Issue{
+		{
+			FromLinter:  "a",
+			Pos:         token.Position{Filename: file, Line: 4},
+			Replacement: &result.Replacement{NewLines: []string{"var y = qux"}},
+		},
+		{
+			FromLinter: "b",
+			Pos:        token.Position{Filename: file, Line: 1},
+		},
+	}
+
+	p := NewFixer(cfg, logutils.NewStderrLog(logutils.DebugKeyEmpty), fsutils.NewFileCache())
+
+	got, err := p.Process(issues)
+	require.NoError(t, err)
+
+	assert.Equal(t, issues, got)
+
+	data, err := os.ReadFile(file)
+	require.NoError(t, err)
+
+	assert.Equal(t, content, string(data))
+
+	diff, err := os.ReadFile(cfg.Issues.FixDiffPath)
+	require.NoError(t, err)
+
+	name, err := normalizeFixPath(file)
+	require.NoError(t, err)
+
+	name = filepath.ToSlash(name)
+
+	expected := "--- a/" + name + "\n" +
+		"+++ b/" + name + "\n" +
+		"@@ -1,4 +1,4 @@\n" +
+		" package a\n" +
+		" \n" +
+		" var x = foo(1)\n" +
+		"-var y = bar\n" +
+		"+var y = qux\n"
+
+	assert.Equal(t, expected, string(diff))
+}
@@ -6,7 +6,7 @@ import (
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
-var _ Processor = (*IdentifierMarker)(nil)
+var _ StreamProcessor = (*IdentifierMarker)(nil)
 
 type replacePattern struct {
 	re   string
@@ -132,6 +132,10 @@ func (IdentifierMarker) Name() string {
 	return "identifier_marker"
 }
 
+func (IdentifierMarker) IsStreamable() bool {
+	return true
+}
+
 func (p IdentifierMarker) Process(issues []result.Issue) ([]result.Issue, error) {
 	return transformIssues(issues, func(issue *result.Issue) *result.Issue {
 		newIssue := *issue
@@ -7,20 +7,33 @@ import (
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
-var _ Processor = (*InvalidIssue)(nil)
+var _ StreamProcessor = (*InvalidIssue)(nil)
 
 type InvalidIssue struct {
 	log logutils.Log
+
+	// anyFileLinters are the linters reporting issues on files other than Go files (ex: the `command` linters).
+	anyFileLinters map[string]bool
 }
 
-func NewInvalidIssue(log logutils.Log) *InvalidIssue {
-	return &InvalidIssue{log: log}
+func NewInvalidIssue(log logutils.Log, anyFileLinters []string) *InvalidIssue {
+	p := &InvalidIssue{log: log, anyFileLinters: map[string]bool{}}
+
+	for _, name := range anyFileLinters {
+		p.anyFileLinters[name] = true
+	}
+
+	return p
 }
 
 func (InvalidIssue) Name() string {
 	return "invalid_issue"
 }
 
+func (InvalidIssue) IsStreamable() bool {
+	return true
+}
+
 func (p InvalidIssue) Process(issues []result.Issue) ([]result.Issue, error) {
 	tcIssues := filterIssuesUnsafe(issues, func(issue *result.Issue) bool {
 		return issue.FromLinter == typeCheckName
@@ -42,7 +55,7 @@ func (p InvalidIssue) shouldPassIssue(issue *result.Issue) (bool, error) {
 		return false, nil
 	}
 
-	if filepath.Base(issue.FilePath()) == "go.mod" {
+	if filepath.Base(issue.FilePath()) == "go.mod" || p.anyFileLinters[issue.FromLinter] {
 		return true, nil
 	}
 
@@ -15,7 +15,7 @@ func TestInvalidIssue_Process(t *testing.T) {
 	logger := logutils.NewStderrLog(logutils.DebugKeyInvalidIssue)
 	logger.SetLevel(logutils.LogLevelDebug)
 
-	p := NewInvalidIssue(logger)
+	p := NewInvalidIssue(logger, []string{"command"})
 
 	testCases := []struct {
 		desc     string
@@ -96,6 +96,25 @@ func TestInvalidIssue_Process(t *testing.T) {
 			},
 			expected: []result.Issue{},
 		},
+		{
+			desc: "non Go file of a command linter",
+			issues: []result.Issue{
+				{
+					FromLinter: "command",
+					Pos: token.Position{
+						Filename: "api/service.proto",
+					},
+				},
+			},
+			expected: []result.Issue{
+				{
+					FromLinter: "command",
+					Pos: token.Position{
+						Filename: "api/service.proto",
+					},
+				},
+			},
+		},
 		{
 			desc: "no filename",
 			issues: []result.Issue{
@@ -10,6 +10,7 @@ import (
 
 	"golang.org/x/exp/maps"
 
+	"github.com/golangci/golangci-lint/pkg/fsutils"
 	"github.com/golangci/golangci-lint/pkg/golinters/nolintlint"
 	"github.com/golangci/golangci-lint/pkg/lint/linter"
 	"github.com/golangci/golangci-lint/pkg/lint/lintersdb"
@@ -17,12 +18,12 @@ import (
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
-var _ Processor = (*Nolint)(nil)
+var _ StreamProcessor = (*Nolint)(nil)
 
 var nolintDebugf = logutils.Debug(logutils.DebugKeyNolint)
 
 type ignoredRange struct {
-	linters                []string
+	linters                []string // the linters, or the rules of the linters (`linter/rule`)
 	matchedIssueFromLinter map[string]bool
 	result.Range
 	col           int
@@ -37,8 +38,10 @@ func (i *ignoredRange) doesMatch(issue *result.Issue) bool {
 	// only allow selective nolinting of nolintlint
 	nolintFoundForLinter := len(i.linters) == 0 && issue.FromLinter != nolintlint.LinterName
 
-	for _, linterName := range i.linters {
-		if linterName == issue.FromLinter {
+	for _, name := range i.linters {
+		linterName, ruleID, _ := strings.Cut(name, "/")
+
+		if linterName == issue.FromLinter && (ruleID == "" || ruleID == issue.RuleID) {
 			nolintFoundForLinter = true
 			break
 		}
@@ -60,12 +63,22 @@ func (i *ignoredRange) doesMatch(issue *result.Issue) bool {
 	return false
 }
 
+// setMatched records the linter, and the rule, of an issue matched by the range.
+func (i *ignoredRange) setMatched(issue *result.Issue) {
+	i.matchedIssueFromLinter[issue.FromLinter] = true
+
+	if issue.RuleID != "" {
+		i.matchedIssueFromLinter[issue.Rule()] = true
+	}
+}
+
 type fileData struct {
 	ignoredRanges []ignoredRange
 }
 
 type Nolint struct {
-	fileCache      map[string]*fileData
+	filesData      map[string]*fileData
+	fileCache      *fsutils.FileCache
 	dbManager      *lintersdb.Manager
 	enabledLinters map[string]*linter.Config
 	log            logutils.Log
@@ -75,9 +88,12 @@ type Nolint struct {
 	pattern *regexp.Regexp
 }
 
-func NewNolint(log logutils.Log, dbManager *lintersdb.Manager, enabledLinters map[string]*linter.Config) *Nolint {
+func NewNolint(log logutils.Log, dbManager *lintersdb.Manager, enabledLinters map[string]*linter.Config,
+	fileCache *fsutils.FileCache,
+) *Nolint {
 	return &Nolint{
-		fileCache:         map[string]*fileData{},
+		filesData:         map[string]*fileData{},
+		fileCache:         fileCache,
 		dbManager:         dbManager,
 		enabledLinters:    enabledLinters,
 		log:               log,
@@ -90,6 +106,10 @@ func (*Nolint) Name() string {
 	return "nolint"
 }
 
+func (*Nolint) IsStreamable() bool {
+	return true
+}
+
 func (p *Nolint) Process(issues []result.Issue) ([]result.Issue, error) {
 	// put nolintlint issues last because we process other issues first to determine which nolint directives are unused
 	sort.Stable(sortWithNolintlintLast(issues))
@@ -114,7 +134,10 @@ func (p *Nolint) shouldPassIssue(issue *result.Issue) (bool, error) {
 	if issue.FromLinter == nolintlint.LinterName && issue.ExpectNoLint && issue.ExpectedNoLintLinter != "" {
 		nolintDebugf("enabled linters: %v", p.enabledLinters)
 
-		if p.enabledLinters[issue.ExpectedNoLintLinter] == nil {
+		// The expected linter can be a rule of a linter (`linter/rule`).
+		expectedLinter, _, _ := strings.Cut(issue.ExpectedNoLintLinter, "/")
+
+		if p.enabledLinters[expectedLinter] == nil {
 			return false, nil
 		}
 
@@ -130,10 +153,10 @@ func (p *Nolint) shouldPassIssue(issue *result.Issue) (bool, error) {
 
 		nolintDebugf("found ignored range for issue %v: %v", issue, ir)
 
-		ir.matchedIssueFromLinter[issue.FromLinter] = true
+		ir.setMatched(issue)
 
 		if ir.originalRange != nil {
-			ir.originalRange.matchedIssueFromLinter[issue.FromLinter] = true
+			ir.originalRange.setMatched(issue)
 		}
 
 		return false, nil
@@ -143,20 +166,27 @@ func (p *Nolint) shouldPassIssue(issue *result.Issue) (bool, error) {
 }
 
 func (p *Nolint) getOrCreateFileData(issue *result.Issue) *fileData {
-	fd := p.fileCache[issue.FilePath()]
+	fd := p.filesData[issue.FilePath()]
 	if fd != nil {
 		return fd
 	}
 
 	fd = &fileData{}
-	p.fileCache[issue.FilePath()] = fd
+	p.filesData[issue.FilePath()] = fd
 
 	// TODO: migrate this parsing to go/analysis facts
 	// or cache them somehow per file.
 
+	// The content comes from the file cache because it can differ from the disk (e.g. unsaved files of an editor).
+	src, err := p.fileCache.GetFileBytes(issue.FilePath())
+	if err != nil {
+		// Don't report error because it's already must be reporter by typecheck or go/analysis.
+		return fd
+	}
+
 	// Don't use cached AST because they consume a lot of memory on large projects.
 	fset := token.NewFileSet()
-	f, err := parser.ParseFile(fset, issue.FilePath(), nil, parser.ParseComments)
+	f, err := parser.ParseFile(fset, issue.FilePath(), src, parser.ParseComments)
 	if err != nil {
 		// Don't report error because it's already must be reporter by typecheck or go/analysis.
 		return fd
@@ -233,7 +263,10 @@ func (p *Nolint) extractInlineRangeFromComment(text string, g ast.Node, fset *to
 	text = strings.Split(text, "//")[0] // allow another comment after this comment
 	linterItems := strings.Split(strings.TrimPrefix(text, "nolint:"), ",")
 	for _, item := range linterItems {
-		linterName := strings.ToLower(strings.TrimSpace(item))
+		// The item can be a rule of a linter: `linter/rule` (ex: `staticcheck/SA4006`).
+		linterName, ruleID, _ := strings.Cut(strings.TrimSpace(item), "/")
+
+		linterName = strings.ToLower(linterName)
 		if linterName == "all" {
 			p.unknownLintersSet = map[string]bool{}
 			return buildRange(nil)
@@ -242,13 +275,13 @@ func (p *Nolint) extractInlineRangeFromComment(text string, g ast.Node, fset *to
 		lcs := p.dbManager.GetLinterConfigs(linterName)
 		if lcs == nil {
 			p.unknownLintersSet[linterName] = true
-			linters = append(linters, linterName)
+			linters = append(linters, withRuleID(linterName, ruleID))
 			nolintDebugf("unknown linter %s on line %d", linterName, fset.Position(g.Pos()).Line)
 			continue
 		}
 
 		for _, lc := range lcs {
-			linters = append(linters, lc.Name()) // normalize name to work with aliases
+			linters = append(linters, withRuleID(lc.Name(), ruleID)) // normalize name to work with aliases
 		}
 	}
 
@@ -256,6 +289,14 @@ func (p *Nolint) extractInlineRangeFromComment(text string, g ast.Node, fset *to
 	return buildRange(linters)
 }
 
+func withRuleID(linterName, ruleID string) string {
+	if ruleID == "" {
+		return linterName
+	}
+
+	return linterName + "/" + ruleID
+}
+
 type rangeExpander struct {
 	fset           *token.FileSet
 	inlineRanges   []ignoredRange
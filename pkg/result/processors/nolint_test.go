@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/fsutils"
 	"github.com/golangci/golangci-lint/pkg/golinters/nolintlint"
 	"github.com/golangci/golangci-lint/pkg/lint/lintersdb"
 	"github.com/golangci/golangci-lint/pkg/logutils"
@@ -36,7 +37,7 @@ func newNolint2FileIssue(line int) result.Issue {
 func newTestNolintProcessor(log logutils.Log) *Nolint {
 	dbManager, _ := lintersdb.NewManager(log, config.NewDefault(), lintersdb.NewLinterBuilder())
 
-	return NewNolint(log, dbManager, nil)
+	return NewNolint(log, dbManager, nil, fsutils.NewFileCache())
 }
 
 func getMockLog() *logutils.MockLog {
@@ -190,6 +191,30 @@ func TestNolintAliases(t *testing.T) {
 	p.Finish()
 }
 
+func TestNolintRule(t *testing.T) {
+	fileName := filepath.Join("testdata", "nolint_rule.go")
+
+	newIssue := func(line int, fromLinter, ruleID string) result.Issue {
+		return result.Issue{
+			Pos:        token.Position{Filename: fileName, Line: line},
+			FromLinter: fromLinter,
+			RuleID:     ruleID,
+		}
+	}
+
+	p := newTestNolintProcessor(getMockLog())
+	defer p.Finish()
+
+	processAssertEmpty(t, p, newIssue(3, "staticcheck", "SA4006"))
+	processAssertSame(t, p, newIssue(3, "staticcheck", "SA1019"))
+	processAssertSame(t, p, newIssue(3, "staticcheck", ""))
+	processAssertSame(t, p, newIssue(3, "gosec", "SA4006"))
+
+	processAssertEmpty(t, p, newIssue(5, "staticcheck", "SA4006"))
+	processAssertEmpty(t, p, newIssue(5, "gosec", "G104"))
+	processAssertSame(t, p, newIssue(5, "gosec", "G304"))
+}
+
 func TestIgnoredRangeMatches(t *testing.T) {
 	testcases := []struct {
 		doc      string
@@ -234,6 +259,29 @@ func TestIgnoredRangeMatches(t *testing.T) {
 			linters:  []string{"vet"},
 			expected: true,
 		},
+		{
+			doc: "matched line and rule",
+			issue: result.Issue{
+				Pos: token.Position{
+					Line: 20,
+				},
+				FromLinter: "staticcheck",
+				RuleID:     "SA4006",
+			},
+			linters:  []string{"staticcheck/SA4006"},
+			expected: true,
+		},
+		{
+			doc: "matched line and linter, unmatched rule",
+			issue: result.Issue{
+				Pos: token.Position{
+					Line: 20,
+				},
+				FromLinter: "staticcheck",
+				RuleID:     "SA1019",
+			},
+			linters: []string{"staticcheck/SA4006"},
+		},
 	}
 
 	for _, testcase := range testcases {
@@ -291,7 +339,7 @@ func TestNolintUnused(t *testing.T) {
 		enabledLintersMap, err := dbManager.GetEnabledLintersMap()
 		require.NoError(t, err)
 
-		return NewNolint(log, dbManager, enabledLintersMap)
+		return NewNolint(log, dbManager, enabledLintersMap, fsutils.NewFileCache())
 	}
 
 	// the issue below is the nolintlint issue that would be generated for the test file
@@ -343,6 +391,30 @@ func TestNolintUnused(t *testing.T) {
 		}, nolintlintIssueVarcheck}...)
 	})
 
+	t.Run("when an issue of a rule occurs, it is removed from the nolintlint issues", func(t *testing.T) {
+		fileNameRule := filepath.Join("testdata", "nolint_rule.go")
+
+		p := createProcessor(t, log, []string{"nolintlint", "staticcheck"})
+		defer p.Finish()
+
+		processAssertEmpty(t, p, []result.Issue{{
+			Pos: token.Position{
+				Filename: fileNameRule,
+				Line:     3,
+			},
+			FromLinter: "staticcheck",
+			RuleID:     "SA4006",
+		}, {
+			Pos: token.Position{
+				Filename: fileNameRule,
+				Line:     3,
+			},
+			FromLinter:           nolintlint.LinterName,
+			ExpectNoLint:         true,
+			ExpectedNoLintLinter: "staticcheck/SA4006",
+		}}...)
+	})
+
 	t.Run("when a linter is not enabled, it is removed from the nolintlint unused issues", func(t *testing.T) {
 		enabledSetLog := logutils.NewMockLog()
 		enabledSetLog.On("Infof", "Active %d linters: %s", 1, []string{"nolintlint"})
@@ -355,7 +427,7 @@ func TestNolintUnused(t *testing.T) {
 		enabledLintersMap, err := dbManager.GetEnabledLintersMap()
 		require.NoError(t, err)
 
-		p := NewNolint(log, dbManager, enabledLintersMap)
+		p := NewNolint(log, dbManager, enabledLintersMap, fsutils.NewFileCache())
 		defer p.Finish()
 
 		processAssertEmpty(t, p, nolintlintIssueVarcheck)
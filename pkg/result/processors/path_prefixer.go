@@ -5,7 +5,7 @@ import (
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
-var _ Processor = (*PathPrefixer)(nil)
+var _ StreamProcessor = (*PathPrefixer)(nil)
 
 // PathPrefixer adds a customizable prefix to every output path
 type PathPrefixer struct {
@@ -22,6 +22,10 @@ func (*PathPrefixer) Name() string {
 	return "path_prefixer"
 }
 
+func (*PathPrefixer) IsStreamable() bool {
+	return true
+}
+
 // Process adds the prefix to each path
 func (p *PathPrefixer) Process(issues []result.Issue) ([]result.Issue, error) {
 	if p.prefix != "" {
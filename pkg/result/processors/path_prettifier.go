@@ -7,7 +7,7 @@ import (
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
-var _ Processor = (*PathPrettifier)(nil)
+var _ StreamProcessor = (*PathPrettifier)(nil)
 
 type PathPrettifier struct {
 }
@@ -20,6 +20,10 @@ func (PathPrettifier) Name() string {
 	return "path_prettifier"
 }
 
+func (PathPrettifier) IsStreamable() bool {
+	return true
+}
+
 func (PathPrettifier) Process(issues []result.Issue) ([]result.Issue, error) {
 	return transformIssues(issues, func(issue *result.Issue) *result.Issue {
 		if !filepath.IsAbs(issue.FilePath()) {
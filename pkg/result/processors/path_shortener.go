@@ -8,7 +8,7 @@ import (
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
-var _ Processor = (*PathShortener)(nil)
+var _ StreamProcessor = (*PathShortener)(nil)
 
 type PathShortener struct {
 	wd string
@@ -27,6 +27,10 @@ func (PathShortener) Name() string {
 	return "path_shortener"
 }
 
+func (PathShortener) IsStreamable() bool {
+	return true
+}
+
 func (p PathShortener) Process(issues []result.Issue) ([]result.Issue, error) {
 	return transformIssues(issues, func(issue *result.Issue) *result.Issue {
 		newIssue := issue
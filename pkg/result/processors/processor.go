@@ -11,3 +11,13 @@ type Processor interface {
 	Name() string
 	Finish()
 }
+
+// StreamProcessor is a processor able to process the issues as the linters report them:
+// processing the issues in several calls of Process gives the same result as processing all the issues at once.
+// The issues are streamed after the streamable processors, the other processors process all the issues at the end of the run.
+type StreamProcessor interface {
+	Processor
+
+	// IsStreamable returns true if the processor can process the issues in several calls.
+	IsStreamable() bool
+}
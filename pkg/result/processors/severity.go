@@ -11,7 +11,7 @@ import (
 
 const severityFromLinter = "@linter"
 
-var _ Processor = (*Severity)(nil)
+var _ StreamProcessor = (*Severity)(nil)
 
 type severityRule struct {
 	baseRule
@@ -50,6 +50,10 @@ func NewSeverity(log logutils.Log, files *fsutils.Files, cfg *config.Severity) *
 
 func (p *Severity) Name() string { return p.name }
 
+func (*Severity) IsStreamable() bool {
+	return true
+}
+
 func (p *Severity) Process(issues []result.Issue) ([]result.Issue, error) {
 	if len(p.rules) == 0 && p.defaultSeverity == "" {
 		return issues, nil
@@ -86,9 +90,12 @@ func (p *Severity) transform(issue *result.Issue) *result.Issue {
 func createSeverityRules(rules []config.SeverityRule, prefix string) []severityRule {
 	parsedRules := make([]severityRule, 0, len(rules))
 
-	for _, rule := range rules {
+	for i := range rules {
+		rule := &rules[i]
+
 		parsedRule := severityRule{}
 		parsedRule.linters = rule.Linters
+		parsedRule.rules = rule.Rules
 		parsedRule.severity = rule.Severity
 
 		if rule.Text != "" {
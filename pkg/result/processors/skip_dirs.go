@@ -10,7 +10,7 @@ import (
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
-var _ Processor = (*SkipDirs)(nil)
+var _ StreamProcessor = (*SkipDirs)(nil)
 
 var StdExcludeDirRegexps = []string{
 	normalizePathRegex("vendor"),
@@ -65,6 +65,10 @@ func (*SkipDirs) Name() string {
 	return "skip_dirs"
 }
 
+func (*SkipDirs) IsStreamable() bool {
+	return true
+}
+
 func (p *SkipDirs) Process(issues []result.Issue) ([]result.Issue, error) {
 	if len(p.patterns) == 0 {
 		return issues, nil
@@ -8,7 +8,7 @@ import (
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
-var _ Processor = (*SkipFiles)(nil)
+var _ StreamProcessor = (*SkipFiles)(nil)
 
 type SkipFiles struct {
 	patterns   []*regexp.Regexp
@@ -38,6 +38,10 @@ func (SkipFiles) Name() string {
 	return "skip_files"
 }
 
+func (SkipFiles) IsStreamable() bool {
+	return true
+}
+
 func (p SkipFiles) Process(issues []result.Issue) ([]result.Issue, error) {
 	if len(p.patterns) == 0 {
 		return issues, nil
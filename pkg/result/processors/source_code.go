@@ -6,7 +6,7 @@ import (
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
-var _ Processor = (*SourceCode)(nil)
+var _ StreamProcessor = (*SourceCode)(nil)
 
 type SourceCode struct {
 	lineCache *fsutils.LineCache
@@ -24,6 +24,10 @@ func (SourceCode) Name() string {
 	return "source_code"
 }
 
+func (SourceCode) IsStreamable() bool {
+	return true
+}
+
 func (p SourceCode) Process(issues []result.Issue) ([]result.Issue, error) {
 	return transformIssues(issues, p.transform), nil
 }
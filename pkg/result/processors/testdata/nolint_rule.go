@@ -0,0 +1,5 @@
+package testdata
+
+var nolintRule int //nolint:staticcheck/SA4006
+
+var nolintRules int //nolint:staticcheck/SA4006,gosec/G104
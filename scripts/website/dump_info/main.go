@@ -91,7 +91,7 @@ func saveCLIHelp(dst string) error {
 		return fmt.Errorf("can't run make build: %w", err)
 	}
 
-	lintersOut, err := exec.Command("./golangci-lint", "help", "linters").Output()
+	lintersOut, err := exec.Command("./golangci-lint", "help", "linters", "--no-config").Output()
 	if err != nil {
 		return fmt.Errorf("can't run linters cmd: %w", err)
 	}
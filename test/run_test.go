@@ -1,9 +1,11 @@
 package test
 
 import (
+	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	_ "github.com/valyala/quicktemplate"
 
@@ -564,3 +566,25 @@ func TestPathPrefix(t *testing.T) {
 		})
 	}
 }
+
+func TestBaselineWriteTypecheck(t *testing.T) {
+	baselinePath := filepath.Join(t.TempDir(), "baseline.json")
+
+	// The compilation errors are not recorded in the baseline, but they are still reported.
+	testshared.NewRunnerBuilder(t).
+		WithNoConfig().
+		WithArgs(
+			"--print-issued-lines=false",
+			"--baseline-write", baselinePath,
+		).
+		WithTargetPath(testdataDir, "notcompiles", "typecheck.go").
+		Runner().
+		Install().
+		Run().
+		ExpectHasIssue("expected declaration, found fun (typecheck)")
+
+	data, err := os.ReadFile(baselinePath)
+	require.NoError(t, err)
+
+	assert.NotContains(t, string(data), "typecheck")
+}